@@ -0,0 +1,62 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/compare"
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/reporter"
+)
+
+// Exit codes of the compare command
+const (
+	exitOK         = 0
+	exitRegression = 1
+	exitUsage      = 2
+)
+
+// runCompare implements the "compare" command and returns the process exit code
+func runCompare(args []string) int {
+	fs := flag.NewFlagSet("compare", flag.ContinueOnError)
+	threshold := fs.Float64("threshold", 5, "Regression threshold in percent for size, marshal and unmarshal medians")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage:\n  %s compare [options] <baseline> <new>\n\n", os.Args[0])
+		fmt.Fprintf(fs.Output(), "Compares two saved result files (serialization_results_*.csv or results_*.json)\n")
+		fmt.Fprintf(fs.Output(), "and exits with status %d when a regression beyond the threshold is found.\n\n", exitRegression)
+		fmt.Fprintf(fs.Output(), "Options:\n")
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	base, err := compare.LoadResults(fs.Arg(0))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load baseline results: %v\n", err)
+		return exitUsage
+	}
+	current, err := compare.LoadResults(fs.Arg(1))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load new results: %v\n", err)
+		return exitUsage
+	}
+
+	fmt.Printf("Baseline: %s\n", fs.Arg(0))
+	fmt.Printf("New:      %s\n", fs.Arg(1))
+
+	comparison := compare.Compare(base, current, *threshold/100)
+	reporter.NewReporter("").PrintComparison(comparison)
+
+	if comparison.HasRegression() {
+		fmt.Println("\nRegression detected.")
+		return exitRegression
+	}
+	fmt.Println("\nNo regression detected.")
+	return exitOK
+}
@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/benchmark"
 	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/models"
@@ -14,15 +17,35 @@ import (
 )
 
 func main() {
+	// Subcommands
+	if len(os.Args) > 1 && os.Args[1] == "compare" {
+		os.Exit(runCompare(os.Args[2:]))
+	}
+
 	// Command line flags
 	var (
 		dataCount     = flag.Int("count", 100000, "Number of test records to generate")
+		seed          = flag.Int64("seed", 1, "Seed for test data generation (same seed yields identical data)")
+		profileName   = flag.String("profile", "typical", "Test data profile: minimal, typical, heavy, pathological, or a JSON profile config file")
+		dataFile      = flag.String("data-file", "", "Load test data from this file instead of generating it")
+		dataFormat    = flag.String("data-format", "auto", "Format of -data-file: auto, json, ndjson, or a serializer name (e.g. MsgPack)")
 		iterations    = flag.Int("iterations", 5, "Number of benchmark iterations")
+		warmup        = flag.Int("warmup", 1, "Number of untimed warmup iterations before measuring")
+		mode          = flag.String("mode", "slice", "Comma-separated benchmark modes: slice, per-record, compression, stream, reuse, partial, concurrency, fidelity, corruption, or all")
+		only          = flag.String("only", "", "Comma-separated serializer names or glob patterns to benchmark, e.g. '*JSON*' or 'Msgp+gzip' (default all uncompressed, non-optional)")
+		streamCount   = flag.Int("stream-count", 1000000, "Number of generated records to stream in stream mode")
+		streamDir     = flag.String("stream-dir", "", "Directory for the temporary stream file in stream mode (default system temp dir)")
+		partialFields = flag.String("partial-fields", "ID,Email", "Comma-separated Go field paths read in partial mode, e.g. 'ID,Profile.FirstName'")
+		concurrency   = flag.String("concurrency", "1,2,4,8,GOMAXPROCS", "Comma-separated goroutine counts in concurrency mode (GOMAXPROCS for the current value)")
+		concDuration  = flag.Duration("concurrency-duration", time.Second, "How long each operation runs per goroutine count in concurrency mode")
+		exclude       = flag.String("exclude", "", "Comma-separated serializer names or glob patterns to skip")
+		list          = flag.Bool("list", false, "List registered and optional serializers")
 		redisAddr     = flag.String("redis-addr", "localhost:6379", "Redis server address")
 		redisPassword = flag.String("redis-password", "", "Redis password")
 		redisDB       = flag.Int("redis-db", 0, "Redis database number")
 		outputDir     = flag.String("output", "./results", "Output directory for results")
 		skipRedis     = flag.Bool("skip-redis", false, "Skip Redis benchmarks")
+		saveJSON      = flag.Bool("json", true, "Save a combined JSON report with run metadata")
 		help          = flag.Bool("help", false, "Show help message")
 	)
 	flag.Parse()
@@ -32,51 +55,269 @@ func main() {
 		return
 	}
 
+	if *list {
+		listSerializers()
+		return
+	}
+
+	selected, err := serializers.Select(serializers.ParsePatterns(*only), serializers.ParsePatterns(*exclude))
+	if err != nil {
+		log.Fatalf("Invalid serializer selection: %v", err)
+	}
+
+	modes, err := benchmark.ParseModes(*mode)
+	if err != nil {
+		log.Fatalf("Invalid -mode: %v", err)
+	}
+
+	profile, err := models.ResolveProfile(*profileName)
+	if err != nil {
+		log.Fatalf("Invalid -profile: %v", err)
+	}
+
+	fields, err := serializers.ParseFields(*partialFields)
+	if err != nil {
+		log.Fatalf("Invalid -partial-fields: %v", err)
+	}
+
+	workerCounts, err := benchmark.ParseWorkerCounts(*concurrency)
+	if err != nil {
+		log.Fatalf("Invalid -concurrency: %v", err)
+	}
+	if *concDuration <= 0 {
+		log.Fatalf("Invalid -concurrency-duration: must be positive")
+	}
+
 	fmt.Printf("Serializer Performance Benchmark\n")
 	fmt.Printf("=================================\n")
-	fmt.Printf("Test data count: %d\n", *dataCount)
-	fmt.Printf("Benchmark iterations: %d\n", *iterations)
+	if *dataFile != "" {
+		fmt.Printf("Test data file: %s (format: %s)\n", *dataFile, *dataFormat)
+	} else {
+		fmt.Printf("Test data count: %d (seed: %d, profile: %s)\n", *dataCount, *seed, profile.Name)
+	}
+	fmt.Printf("Benchmark iterations: %d (warmup: %d)\n", *iterations, *warmup)
+	fmt.Printf("Benchmark modes: %s\n", *mode)
+	fmt.Printf("Serializers: %s\n", strings.Join(serializerNames(selected), ", "))
 	fmt.Printf("Output directory: %s\n", *outputDir)
 	fmt.Printf("Redis: %s (skip: %t)\n\n", *redisAddr, *skipRedis)
 
 	// Initialize reporter
 	rep := reporter.NewReporter(*outputDir)
+	if *dataFile != "" {
+		rep.SetRunInfo("DataFile", *dataFile)
+	} else {
+		rep.SetRunInfo("Seed", strconv.FormatInt(*seed, 10))
+		rep.SetRunInfo("Profile", profile.Name)
+	}
 	if err := rep.EnsureOutputDir(); err != nil {
 		log.Fatalf("Failed to create output directory: %v", err)
 	}
 
-	// Generate test data
-	fmt.Printf("Generating %d test records...\n", *dataCount)
-	users := models.GenerateTestUsers(*dataCount)
-	fmt.Printf("Test data generated successfully.\n\n")
+	// Generate or load test data
+	var users models.Users
+	if *dataFile != "" {
+		users = loadTestData(*dataFile, *dataFormat)
+	} else {
+		fmt.Printf("Generating %d test records...\n", *dataCount)
+		users = models.GenerateTestUsers(*dataCount, *seed, profile)
+		fmt.Printf("Test data generated successfully.\n\n")
+	}
+
+	// Collect run metadata for the JSON report
+	report := reporter.RunReport{
+		Metadata: reporter.CollectMetadata(collectFlags(), len(users), *seed, profile),
+	}
+	if *dataFile != "" {
+		report.Metadata.DataFile = *dataFile
+	}
 
 	// Initialize benchmark runner
 	runner := benchmark.NewRunner()
 	runner.SetTestData(users)
+	runner.SetModes(modes)
+	runner.SetWarmup(*warmup)
 
-	// Add all serializers (JSON first, then alphabetical order)
-	runner.AddSerializer(serializers.NewJSONSerializer()) // Most common format first
-	runner.AddSerializer(serializers.NewCBORSerializer())
-	runner.AddSerializer(serializers.NewEasyJSONSerializer())
-	runner.AddSerializer(serializers.NewFlatBuffersSerializer())
-	runner.AddSerializer(serializers.NewGobSerializer())
-	runner.AddSerializer(serializers.NewGoJSONSerializer())
-	runner.AddSerializer(serializers.NewJSONiterSerializer())
-	runner.AddSerializer(serializers.NewMsgpSerializer())
-	runner.AddSerializer(serializers.NewMsgPackSerializer())
-	runner.AddSerializer(serializers.NewProtobufSerializer())
+	// Add selected serializers
+	for _, s := range serializers.NewSerializers(selected) {
+		runner.AddSerializer(s)
+	}
 
 	// Run serialization benchmarks
-	fmt.Println("Running serialization benchmarks...")
-	serializationResults, err := runner.RunBenchmarks(*iterations)
-	if err != nil {
-		log.Fatalf("Serialization benchmark failed: %v", err)
+	if runner.HasMode(benchmark.ModeSlice) {
+		fmt.Println("Running serialization benchmarks...")
+		serializationResults, err := runner.RunBenchmarks(*iterations)
+		if err != nil {
+			log.Fatalf("Serialization benchmark failed: %v", err)
+		}
+
+		report.Serialization = serializationResults
+
+		// Print and save serialization results
+		rep.PrintSerializationResults(serializationResults)
+		rep.PrintSignificanceResults(serializationResults)
+		if err := rep.SaveSerializationResults(serializationResults); err != nil {
+			log.Printf("Failed to save serialization results: %v", err)
+		}
+	}
+
+	// Run per-record benchmarks
+	if runner.HasMode(benchmark.ModePerRecord) {
+		fmt.Println("\nRunning per-record benchmarks...")
+		perRecordResults, err := runner.RunPerRecordBenchmarks(*iterations)
+		if err != nil {
+			log.Fatalf("Per-record benchmark failed: %v", err)
+		}
+
+		report.PerRecord = perRecordResults
+
+		// Print and save per-record results
+		rep.PrintPerRecordResults(perRecordResults)
+		if err := rep.SavePerRecordResults(perRecordResults); err != nil {
+			log.Printf("Failed to save per-record results: %v", err)
+		}
+	}
+
+	// Run compression analysis
+	if runner.HasMode(benchmark.ModeCompression) {
+		fmt.Println("\nRunning compression analysis...")
+		compressionResults, err := runner.RunCompressionAnalysis()
+		if err != nil {
+			log.Fatalf("Compression analysis failed: %v", err)
+		}
+
+		report.Compression = compressionResults
+
+		// Print and save compression results
+		rep.PrintCompressionResults(compressionResults)
+		if err := rep.SaveCompressionResults(compressionResults); err != nil {
+			log.Printf("Failed to save compression results: %v", err)
+		}
+	}
+
+	// Run streaming benchmarks
+	if runner.HasMode(benchmark.ModeStream) {
+		fmt.Printf("\nRunning stream benchmarks (%d generated records, seed: %d, profile: %s)...\n",
+			*streamCount, *seed, profile.Name)
+		streamResults, err := runner.RunStreamBenchmarks(benchmark.StreamConfig{
+			Count:   *streamCount,
+			Seed:    *seed,
+			Profile: profile,
+			Dir:     *streamDir,
+		}, *iterations)
+		if err != nil {
+			log.Fatalf("Stream benchmark failed: %v", err)
+		}
+
+		report.Stream = streamResults
+
+		// Print and save stream results
+		rep.PrintStreamResults(streamResults)
+		if err := rep.SaveStreamResults(streamResults); err != nil {
+			log.Printf("Failed to save stream results: %v", err)
+		}
+	}
+
+	// Run buffer reuse benchmarks
+	if runner.HasMode(benchmark.ModeReuse) {
+		fmt.Println("\nRunning buffer reuse benchmarks...")
+		reuseResults, err := runner.RunReuseBenchmarks(*iterations)
+		if err != nil {
+			log.Fatalf("Reuse benchmark failed: %v", err)
+		}
+
+		report.Reuse = reuseResults
+
+		// Print and save reuse results
+		rep.PrintReuseResults(reuseResults)
+		if err := rep.SaveReuseResults(reuseResults); err != nil {
+			log.Printf("Failed to save reuse results: %v", err)
+		}
 	}
 
-	// Print and save serialization results
-	rep.PrintSerializationResults(serializationResults)
-	if err := rep.SaveSerializationResults(serializationResults); err != nil {
-		log.Printf("Failed to save serialization results: %v", err)
+	// Run partial read benchmarks
+	if runner.HasMode(benchmark.ModePartial) {
+		fmt.Printf("\nRunning partial read benchmarks (fields: %s)...\n", *partialFields)
+		partialResults, err := runner.RunPartialBenchmarks(*iterations, fields)
+		if err != nil {
+			log.Fatalf("Partial read benchmark failed: %v", err)
+		}
+
+		report.Partial = partialResults
+
+		// Print and save partial read results
+		rep.PrintPartialResults(partialResults)
+		if err := rep.SavePartialResults(partialResults); err != nil {
+			log.Printf("Failed to save partial read results: %v", err)
+		}
+	}
+
+	// Run concurrency benchmarks
+	if runner.HasMode(benchmark.ModeConcurrency) {
+		fmt.Printf("\nRunning concurrency benchmarks (goroutines: %s, %s each)...\n",
+			strings.Trim(fmt.Sprint(workerCounts), "[]"), *concDuration)
+		concurrencyResults, err := runner.RunConcurrencyBenchmarks(benchmark.ConcurrencyConfig{
+			Workers:  workerCounts,
+			Duration: *concDuration,
+		})
+		if err != nil {
+			log.Fatalf("Concurrency benchmark failed: %v", err)
+		}
+
+		report.Concurrency = concurrencyResults
+
+		// Print and save concurrency results
+		rep.PrintConcurrencyResults(concurrencyResults)
+		if err := rep.SaveConcurrencyResults(concurrencyResults); err != nil {
+			log.Printf("Failed to save concurrency results: %v", err)
+		}
+	}
+
+	// Run field-level fidelity tests
+	if runner.HasMode(benchmark.ModeFidelity) {
+		fmt.Println("\nRunning fidelity tests...")
+		fidelityResults, err := runner.RunFidelityTests()
+		if err != nil {
+			log.Fatalf("Fidelity test failed: %v", err)
+		}
+
+		report.Fidelity = fidelityResults
+
+		// Print and save fidelity results
+		rep.PrintFidelityResults(fidelityResults)
+		if err := rep.SaveFidelityResults(fidelityResults); err != nil {
+			log.Printf("Failed to save fidelity results: %v", err)
+		}
+
+		fmt.Println("\nRunning metadata type tests...")
+		metadataTypeResults, err := runner.RunMetadataTypeTests()
+		if err != nil {
+			log.Fatalf("Metadata type test failed: %v", err)
+		}
+
+		report.MetadataTypes = metadataTypeResults
+
+		// Print and save metadata type results
+		rep.PrintMetadataTypeResults(metadataTypeResults)
+		if err := rep.SaveMetadataTypeResults(metadataTypeResults); err != nil {
+			log.Printf("Failed to save metadata type results: %v", err)
+		}
+	}
+
+	// Run corruption robustness tests
+	if runner.HasMode(benchmark.ModeCorruption) {
+		fmt.Println("\nRunning corruption tests...")
+		corruptionResults, err := runner.RunCorruptionTests()
+		if err != nil {
+			log.Fatalf("Corruption test failed: %v", err)
+		}
+
+		report.Corruption = corruptionResults
+
+		// Print and save corruption results
+		rep.PrintCorruptionResults(corruptionResults)
+		if err := rep.SaveCorruptionResults(corruptionResults); err != nil {
+			log.Printf("Failed to save corruption results: %v", err)
+		}
 	}
 
 	// Run symmetry tests
@@ -86,6 +327,8 @@ func main() {
 		log.Fatalf("Symmetry test failed: %v", err)
 	}
 
+	report.Symmetry = symmetryResults
+
 	// Print and save symmetry results
 	rep.PrintSymmetryResults(symmetryResults)
 	if err := rep.SaveSymmetryResults(symmetryResults); err != nil {
@@ -108,24 +351,12 @@ func main() {
 			}
 
 			// Use all users for Redis benchmarks
-			// Create serializers for Redis test (JSON first, then alphabetical order)
-			redisSerializers := []serializers.Serializer{
-				serializers.NewJSONSerializer(), // Most common format first
-				serializers.NewCBORSerializer(),
-				serializers.NewEasyJSONSerializer(),
-				serializers.NewFlatBuffersSerializer(),
-				serializers.NewGobSerializer(),
-				serializers.NewGoJSONSerializer(),
-				serializers.NewJSONiterSerializer(),
-				serializers.NewMsgpSerializer(),
-				serializers.NewMsgPackSerializer(),
-				serializers.NewProtobufSerializer(),
-			}
-
-			redisResults, err := redisClient.BenchmarkRedisOperations(redisSerializers, users, *iterations)
+			redisResults, err := redisClient.BenchmarkRedisOperations(serializers.NewSerializers(selected), users, *iterations)
 			if err != nil {
 				log.Printf("Redis benchmark failed: %v", err)
 			} else {
+				report.Redis = redisResults
+
 				// Print and save Redis results
 				rep.PrintRedisResults(redisResults)
 				if err := rep.SaveRedisResults(redisResults); err != nil {
@@ -140,33 +371,120 @@ func main() {
 		}
 	}
 
+	// Save combined JSON report
+	if *saveJSON {
+		if err := rep.SaveJSONReport(report); err != nil {
+			log.Printf("Failed to save JSON report: %v", err)
+		}
+	}
+
 	fmt.Printf("\nBenchmark completed successfully!\n")
 	fmt.Printf("Results saved to: %s\n", *outputDir)
 }
 
+// serializerNames returns the names of the given registrations
+func serializerNames(registrations []serializers.Registration) []string {
+	names := make([]string, len(registrations))
+	for i, r := range registrations {
+		names[i] = r.Name
+	}
+	return names
+}
+
+// listSerializers prints all registered and optional serializers
+func listSerializers() {
+	width := 12
+	for _, r := range serializers.Optional() {
+		width = max(width, len(r.Name))
+	}
+	fmt.Printf("Registered serializers:\n")
+	for _, r := range serializers.Registered() {
+		fmt.Printf("  %-*s %s\n", width, r.Name, r.Description)
+	}
+	fmt.Printf("\nOptional serializers (benchmarked only when selected with -only):\n")
+	for _, r := range serializers.Optional() {
+		fmt.Printf("  %-*s %s\n", width, r.Name, r.Description)
+	}
+	fmt.Printf("\nCompression codecs: %s\n", strings.Join(serializers.CodecNames(), ", "))
+	fmt.Printf("Select compressed variants as <Serializer>+<codec>[:<level>], e.g. Msgp+gzip or JSON+flate:9\n")
+}
+
+// maxReportedRecordErrors is the number of invalid records listed when loading a data file
+const maxReportedRecordErrors = 10
+
+// loadTestData loads users from a data file, listing the records that were skipped
+func loadTestData(path, format string) models.Users {
+	fmt.Printf("Loading test records from %s...\n", path)
+
+	var decoders []models.UsersDecoder
+	for _, s := range serializers.NewSerializers(serializers.Registered()) {
+		decoders = append(decoders, s)
+	}
+	users, recordErrors, err := models.LoadUsers(path, format, decoders)
+	if err != nil {
+		log.Fatalf("Failed to load test data: %v", err)
+	}
+
+	if len(recordErrors) > 0 {
+		fmt.Printf("Skipped %d invalid records:\n", len(recordErrors))
+		for i, recordErr := range recordErrors {
+			if i == maxReportedRecordErrors {
+				fmt.Printf("  ... and %d more\n", len(recordErrors)-maxReportedRecordErrors)
+				break
+			}
+			fmt.Printf("  %v\n", recordErr)
+		}
+	}
+	if len(users) == 0 {
+		log.Fatalf("No valid test records in %s", path)
+	}
+
+	fmt.Printf("Loaded %d test records successfully.\n\n", len(users))
+	return users
+}
+
+// collectFlags returns the value of every command-line flag, with secrets redacted
+func collectFlags() map[string]string {
+	flags := make(map[string]string)
+	flag.VisitAll(func(f *flag.Flag) {
+		value := f.Value.String()
+		if f.Name == "redis-password" && value != "" {
+			value = "<redacted>"
+		}
+		flags[f.Name] = value
+	})
+	return flags
+}
+
 func showHelp() {
 	fmt.Printf("Serializer Performance Benchmark Tool\n")
 	fmt.Printf("=====================================\n\n")
 	fmt.Printf("This tool compares the performance of different serialization formats:\n")
-	fmt.Printf("- JSON (standard library)\n")
-	fmt.Printf("- CBOR (github.com/fxamacker/cbor/v2)\n")
-	fmt.Printf("- EasyJSON (github.com/mailru/easyjson - high-performance JSON with code generation)\n")
-	fmt.Printf("- FlatBuffers (github.com/google/flatbuffers - zero-copy serialization)\n")
-	fmt.Printf("- Gob (standard library)\n")
-	fmt.Printf("- GoJSON (github.com/goccy/go-json - high-performance JSON)\n")
-	fmt.Printf("- JSONiter (github.com/json-iterator/go - high-performance JSON)\n")
-	fmt.Printf("- Msgp (github.com/tinylib/msgp - high-performance MessagePack with code generation)\n")
-	fmt.Printf("- MsgPack (github.com/vmihailenco/msgpack/v5)\n")
-	fmt.Printf("- Protobuf (google.golang.org/protobuf)\n\n")
+	for _, r := range serializers.Registered() {
+		fmt.Printf("- %s (%s)\n", r.Name, r.Description)
+	}
+	fmt.Printf("\n")
 
 	fmt.Printf("The benchmark measures:\n")
-	fmt.Printf("1. Serialization/deserialization speed (average & median)\n")
+	fmt.Printf("1. Serialization/deserialization speed (average, median, spread, percentiles & outliers)\n")
+	fmt.Printf("   with bootstrap median confidence intervals and pairwise Mann-Whitney U tests\n")
 	fmt.Printf("2. Data size in bytes\n")
-	fmt.Printf("3. Marshal/Unmarshal symmetry for empty/nil slices and maps\n")
-	fmt.Printf("4. Redis SET/GET performance (optional)\n\n")
+	fmt.Printf("3. Per-record ns/op, throughput and size distribution (-mode=per-record)\n")
+	fmt.Printf("   and compressed sizes, entropy and ratios versus JSON (-mode=compression)\n")
+	fmt.Printf("   and streaming throughput and peak heap for very large datasets (-mode=stream)\n")
+	fmt.Printf("   and AppendMarshal/UnmarshalInto buffer reuse versus per-call allocation (-mode=reuse)\n")
+	fmt.Printf("   and reading only selected fields natively versus a full Unmarshal (-mode=partial)\n")
+	fmt.Printf("   and aggregate ops/s, latency percentiles and scaling across goroutines (-mode=concurrency)\n")
+	fmt.Printf("4. Marshal/Unmarshal symmetry for empty/nil slices and maps, time.Time precision and\n")
+	fmt.Printf("   location, int64/int32/float64 number boundaries, and Unicode and hostile strings\n")
+	fmt.Printf("   and per-field value, type, nil-ness and time location fidelity (-mode=fidelity)\n")
+	fmt.Printf("   and the Go types restored for every kind of Metadata value (-mode=fidelity)\n")
+	fmt.Printf("   and Unmarshal outcomes for truncated, bit-flipped and spliced encodings (-mode=corruption)\n")
+	fmt.Printf("5. Redis SET/GET performance (optional)\n\n")
 
 	fmt.Printf("Usage:\n")
-	fmt.Printf("  %s [options]\n\n", os.Args[0])
+	fmt.Printf("  %s [options]\n", os.Args[0])
+	fmt.Printf("  %s compare [-threshold=5] <baseline> <new>\n\n", os.Args[0])
 
 	fmt.Printf("Options:\n")
 	flag.PrintDefaults()
@@ -178,6 +496,40 @@ func showHelp() {
 	fmt.Printf("  # Run with 10k records and skip Redis tests\n")
 	fmt.Printf("  %s -count=10000 -skip-redis\n\n", os.Args[0])
 
+	fmt.Printf("  # Run with large, deeply nested test data\n")
+	fmt.Printf("  %s -profile=heavy -count=10000\n\n", os.Args[0])
+
+	fmt.Printf("  # Run with anonymized production samples instead of generated data\n")
+	fmt.Printf("  %s -data-file=samples.ndjson\n\n", os.Args[0])
+
+	fmt.Printf("  # Benchmark only the JSON family, or everything except Gob\n")
+	fmt.Printf("  %s -only='*JSON*'\n", os.Args[0])
+	fmt.Printf("  %s -exclude=Gob\n\n", os.Args[0])
+
+	fmt.Printf("  # Compare raw and gzip-compressed MessagePack (sizes and times include compression)\n")
+	fmt.Printf("  %s -only=Msgp,Msgp+gzip,Msgp+gzip:9\n\n", os.Args[0])
+
+	fmt.Printf("  # Run both whole-slice and per-record benchmarks\n")
+	fmt.Printf("  %s -mode=slice,per-record\n\n", os.Args[0])
+
+	fmt.Printf("  # Stream 10 million generated records through the streaming serializers\n")
+	fmt.Printf("  %s -mode=stream -stream-count=10000000 -iterations=1 -skip-redis\n\n", os.Args[0])
+
+	fmt.Printf("  # Compare buffer-reusing AppendMarshal/UnmarshalInto with per-call allocation\n")
+	fmt.Printf("  %s -mode=reuse -count=10000 -skip-redis\n\n", os.Args[0])
+
+	fmt.Printf("  # Read only the ID and first name of every user without a full Unmarshal\n")
+	fmt.Printf("  %s -mode=partial -partial-fields=ID,Profile.FirstName -count=10000 -skip-redis\n\n", os.Args[0])
+
+	fmt.Printf("  # Measure throughput from 1, 4, 16 and GOMAXPROCS goroutines for 5 seconds each\n")
+	fmt.Printf("  %s -mode=concurrency -concurrency=1,4,16,GOMAXPROCS -concurrency-duration=5s -skip-redis\n\n", os.Args[0])
+
+	fmt.Printf("  # Check which formats detect truncated, bit-flipped and spliced encodings\n")
+	fmt.Printf("  %s -mode=corruption -count=1000 -skip-redis\n\n", os.Args[0])
+
 	fmt.Printf("  # Run with custom Redis settings\n")
 	fmt.Printf("  %s -redis-addr=192.168.1.100:6379 -redis-password=secret\n\n", os.Args[0])
+
+	fmt.Printf("  # Compare against a baseline run and fail on regressions above 10%%\n")
+	fmt.Printf("  %s compare -threshold=10 results/base.json results/new.json\n\n", os.Args[0])
 }
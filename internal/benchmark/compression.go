@@ -0,0 +1,111 @@
+package benchmark
+
+import (
+	"compress/flate"
+	"fmt"
+
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/serializers"
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/utils"
+)
+
+// analysisCodecs lists the stdlib codecs used for the compressibility analysis
+var analysisCodecs = []string{"gzip", "zlib", "flate"}
+
+// analysisLevels lists the compression levels used for the compressibility analysis
+var analysisLevels = []int{flate.BestSpeed, 6, flate.BestCompression}
+
+// analysisCodecSpecs returns the codec specs ("<codec>:<level>") of the compressibility
+// analysis in report order
+func analysisCodecSpecs() []string {
+	var specs []string
+	for _, codec := range analysisCodecs {
+		for _, level := range analysisLevels {
+			specs = append(specs, fmt.Sprintf("%s:%d", codec, level))
+		}
+	}
+	return specs
+}
+
+// RunCompressionAnalysis compresses the MarshalUsers output of every serializer with each
+// stdlib codec at several levels and compares the sizes against the JSON output
+func (r *Runner) RunCompressionAnalysis() ([]serializers.CompressionResult, error) {
+	specs := analysisCodecSpecs()
+	codecs := make([]serializers.Codec, len(specs))
+	for i, spec := range specs {
+		codec, err := serializers.NewCodec(spec)
+		if err != nil {
+			return nil, err
+		}
+		codecs[i] = codec
+	}
+
+	results := make([]serializers.CompressionResult, 0, len(r.serializers))
+	baselineIndex := -1
+	for _, ser := range r.serializers {
+		fmt.Printf("Running compression analysis for %s...\n", ser.Name())
+		result, err := r.analyzeCompression(ser, codecs)
+		if err != nil {
+			return nil, fmt.Errorf("error analyzing compression of %s: %w", ser.Name(), err)
+		}
+		results = append(results, result)
+		if ser.Name() == "JSON" {
+			baselineIndex = len(results) - 1
+		}
+	}
+
+	// The standard JSON output is the baseline, whether or not JSON is benchmarked
+	var baseline serializers.CompressionResult
+	if baselineIndex >= 0 {
+		baseline = results[baselineIndex]
+	} else {
+		var err error
+		if baseline, err = r.analyzeCompression(serializers.NewJSONSerializer(), codecs); err != nil {
+			return nil, fmt.Errorf("error analyzing compression of JSON: %w", err)
+		}
+	}
+
+	for i := range results {
+		results[i].RawRatioVsJSON = sizeRatio(results[i].RawSize, baseline.RawSize)
+		for j := range results[i].Compressed {
+			results[i].Compressed[j].RatioVsJSON = sizeRatio(results[i].Compressed[j].Size, baseline.Compressed[j].Size)
+		}
+	}
+
+	return results, nil
+}
+
+// analyzeCompression measures the raw size, entropy and compressed sizes of a serializer's output
+func (r *Runner) analyzeCompression(ser serializers.Serializer, codecs []serializers.Codec) (serializers.CompressionResult, error) {
+	data, err := ser.MarshalUsers(r.users)
+	if err != nil {
+		return serializers.CompressionResult{}, fmt.Errorf("marshal failed: %w", err)
+	}
+
+	result := serializers.CompressionResult{
+		SerializerName: ser.Name(),
+		RawSize:        len(data),
+		Entropy:        utils.ShannonEntropy(data),
+		Compressed:     make([]serializers.CompressedSize, len(codecs)),
+	}
+	for i, codec := range codecs {
+		compressed, err := codec.Compress(data)
+		if err != nil {
+			return result, fmt.Errorf("%s compression failed: %w", codec.Name(), err)
+		}
+		result.Compressed[i] = serializers.CompressedSize{
+			Codec: codec.Name(),
+			Size:  len(compressed),
+			Ratio: sizeRatio(len(compressed), len(data)),
+		}
+	}
+
+	return result, nil
+}
+
+// sizeRatio returns size / base, or 0 when base is zero
+func sizeRatio(size, base int) float64 {
+	if base == 0 {
+		return 0
+	}
+	return float64(size) / float64(base)
+}
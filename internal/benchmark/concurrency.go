@@ -0,0 +1,200 @@
+package benchmark
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/serializers"
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/utils"
+)
+
+// latencySamplesPerWorker is the number of per-call latencies each worker keeps for percentiles
+const latencySamplesPerWorker = 10000
+
+// ConcurrencyConfig describes the goroutine counts and duration of RunConcurrencyBenchmarks
+type ConcurrencyConfig struct {
+	Workers  []int         // goroutine counts in ascending order, starting with 1
+	Duration time.Duration // how long each operation runs at each goroutine count
+}
+
+// ParseWorkerCounts parses a comma-separated list of goroutine counts, where "GOMAXPROCS"
+// stands for the current runtime.GOMAXPROCS value. The counts are sorted and deduplicated,
+// and 1 is always included as the baseline for scaling efficiency.
+func ParseWorkerCounts(s string) ([]int, error) {
+	workers := []int{1}
+
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		n := runtime.GOMAXPROCS(0)
+		if !strings.EqualFold(part, "GOMAXPROCS") {
+			var err error
+			if n, err = strconv.Atoi(part); err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid goroutine count %q", part)
+			}
+		}
+		workers = append(workers, n)
+	}
+
+	slices.Sort(workers)
+	return slices.Compact(workers), nil
+}
+
+// concurrentRun contains the outcome of running one operation from several goroutines
+type concurrentRun struct {
+	ops       int64
+	elapsedNs int64
+	latency   utils.LatencyPercentiles
+}
+
+// RunConcurrencyBenchmarks runs per-record Marshal and Unmarshal of every serializer from each
+// configured number of goroutines for a fixed duration and measures the aggregate throughput
+func (r *Runner) RunConcurrencyBenchmarks(config ConcurrencyConfig) ([]serializers.ConcurrencyResult, error) {
+	if len(r.users) == 0 {
+		return nil, fmt.Errorf("no test data provided")
+	}
+
+	var results []serializers.ConcurrencyResult
+	for _, ser := range r.serializers {
+		serResults, err := r.benchmarkConcurrency(ser, config)
+		if err != nil {
+			return nil, fmt.Errorf("error benchmarking %s concurrently: %w", ser.Name(), err)
+		}
+		results = append(results, serResults...)
+	}
+
+	return results, nil
+}
+
+// benchmarkConcurrency runs the concurrency benchmark of a single serializer at every goroutine count
+func (r *Runner) benchmarkConcurrency(ser serializers.Serializer, config ConcurrencyConfig) ([]serializers.ConcurrencyResult, error) {
+	encoded := make([][]byte, len(r.users))
+	for i, user := range r.users {
+		data, err := ser.Marshal(user)
+		if err != nil {
+			return nil, fmt.Errorf("initial marshal failed for user %d: %w", user.ID, err)
+		}
+		encoded[i] = data
+	}
+
+	// Run warmup iterations - results are discarded
+	for i := 0; i < r.warmup; i++ {
+		if _, err := r.measurePerRecord(ser, encoded); err != nil {
+			return nil, fmt.Errorf("warmup iteration %d failed: %w", i+1, err)
+		}
+	}
+
+	marshal := func(i int) error {
+		_, err := ser.Marshal(r.users[i])
+		return err
+	}
+	unmarshal := func(i int) error {
+		_, err := ser.Unmarshal(encoded[i])
+		return err
+	}
+
+	results := make([]serializers.ConcurrencyResult, 0, len(config.Workers))
+	for _, workers := range config.Workers {
+		fmt.Printf("Running concurrency benchmark for %s (%d goroutines)...\n", ser.Name(), workers)
+
+		marshalRun, err := runConcurrent(workers, len(r.users), config.Duration, marshal)
+		if err != nil {
+			return nil, fmt.Errorf("marshal with %d goroutines failed: %w", workers, err)
+		}
+		unmarshalRun, err := runConcurrent(workers, len(r.users), config.Duration, unmarshal)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal with %d goroutines failed: %w", workers, err)
+		}
+
+		results = append(results, serializers.ConcurrencyResult{
+			SerializerName:     ser.Name(),
+			Workers:            workers,
+			DurationNs:         config.Duration.Nanoseconds(),
+			MarshalOps:         marshalRun.ops,
+			UnmarshalOps:       unmarshalRun.ops,
+			MarshalOpsPerSec:   recordsPerSecond(marshalRun.ops, marshalRun.elapsedNs),
+			UnmarshalOpsPerSec: recordsPerSecond(unmarshalRun.ops, unmarshalRun.elapsedNs),
+			MarshalLatency:     marshalRun.latency,
+			UnmarshalLatency:   unmarshalRun.latency,
+		})
+	}
+
+	// Scaling efficiency relative to the single-goroutine run
+	base := results[0]
+	for i := range results {
+		workers := float64(results[i].Workers)
+		results[i].MarshalEfficiency = scalingEfficiency(results[i].MarshalOpsPerSec, base.MarshalOpsPerSec, workers)
+		results[i].UnmarshalEfficiency = scalingEfficiency(results[i].UnmarshalOpsPerSec, base.UnmarshalOpsPerSec, workers)
+	}
+
+	return results, nil
+}
+
+// runConcurrent calls op from the given number of goroutines until duration has elapsed.
+// Each goroutine cycles through the record indexes 0..n-1, starting at a different offset
+// so the goroutines do not process the same record at the same time.
+func runConcurrent(workers, n int, duration time.Duration, op func(i int) error) (concurrentRun, error) {
+	samplers := make([]*utils.LatencySampler, workers)
+	errs := make([]error, workers)
+	var wg sync.WaitGroup
+
+	runtime.GC()
+	start := time.Now()
+	deadline := start.Add(duration)
+	for w := 0; w < workers; w++ {
+		samplers[w] = utils.NewLatencySampler(latencySamplesPerWorker, int64(w+1))
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			sampler := samplers[w]
+			i := w * n / workers
+			for {
+				opStart := time.Now()
+				if err := op(i); err != nil {
+					errs[w] = fmt.Errorf("record %d: %w", i, err)
+					return
+				}
+				opEnd := time.Now()
+				sampler.Add(opEnd.Sub(opStart).Nanoseconds())
+				if opEnd.After(deadline) {
+					return
+				}
+				if i++; i == n {
+					i = 0
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	elapsed := time.Since(start).Nanoseconds()
+
+	if err := errors.Join(errs...); err != nil {
+		return concurrentRun{}, err
+	}
+
+	run := concurrentRun{
+		elapsedNs: elapsed,
+		latency:   utils.MergeLatencies(samplers),
+	}
+	for _, s := range samplers {
+		run.ops += s.Count()
+	}
+	return run, nil
+}
+
+// scalingEfficiency returns opsPerSec relative to workers times the single-goroutine baseOpsPerSec
+func scalingEfficiency(opsPerSec, baseOpsPerSec, workers float64) float64 {
+	if baseOpsPerSec <= 0 || workers <= 0 {
+		return 0
+	}
+	return opsPerSec / (baseOpsPerSec * workers)
+}
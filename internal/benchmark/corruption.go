@@ -0,0 +1,140 @@
+package benchmark
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/models"
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/serializers"
+)
+
+// damage is a controlled corruption applied to a valid encoding
+type damage struct {
+	name string
+	// apply returns a damaged copy of data; other is the encoding of a different user
+	apply func(rng *rand.Rand, data, other []byte) []byte
+}
+
+// damages returns the corruptions applied by the corruption benchmark in report order
+func damages() []damage {
+	return []damage{
+		{"cut at 10%", cutAt(10)},
+		{"cut at 50%", cutAt(50)},
+		{"cut at 90%", cutAt(90)},
+		{"flip 1 bit", flipBits(1)},
+		{"flip 8 bits", flipBits(8)},
+		{"trailing 16B", appendGarbage(16)},
+		{"splice", splice},
+	}
+}
+
+// cutAt truncates the encoding to percent of its length
+func cutAt(percent int) func(rng *rand.Rand, data, other []byte) []byte {
+	return func(rng *rand.Rand, data, other []byte) []byte {
+		return append([]byte(nil), data[:len(data)*percent/100]...)
+	}
+}
+
+// flipBits flips n random bits of the encoding
+func flipBits(n int) func(rng *rand.Rand, data, other []byte) []byte {
+	return func(rng *rand.Rand, data, other []byte) []byte {
+		damaged := append([]byte(nil), data...)
+		if len(damaged) == 0 {
+			return damaged
+		}
+		for i := 0; i < n; i++ {
+			damaged[rng.Intn(len(damaged))] ^= 1 << rng.Intn(8)
+		}
+		return damaged
+	}
+}
+
+// appendGarbage appends n random bytes to the encoding
+func appendGarbage(n int) func(rng *rand.Rand, data, other []byte) []byte {
+	return func(rng *rand.Rand, data, other []byte) []byte {
+		garbage := make([]byte, n)
+		rng.Read(garbage)
+		return append(append([]byte(nil), data...), garbage...)
+	}
+}
+
+// splice joins the first half of the encoding with the second half of another user's encoding
+func splice(rng *rand.Rand, data, other []byte) []byte {
+	return append(append([]byte(nil), data[:len(data)/2]...), other[len(other)/2:]...)
+}
+
+// RunCorruptionTests damages the Marshal output of every user with each corruption and
+// classifies how each serializer's Unmarshal handles the result
+func (r *Runner) RunCorruptionTests() ([]serializers.CorruptionResult, error) {
+	if len(r.users) < 2 {
+		return nil, fmt.Errorf("corruption tests need at least 2 users")
+	}
+
+	results := make([]serializers.CorruptionResult, 0, len(r.serializers))
+	for _, ser := range r.serializers {
+		fmt.Printf("Running corruption test for %s...\n", ser.Name())
+		result, err := r.testCorruption(ser)
+		if err != nil {
+			return nil, fmt.Errorf("error testing corruption of %s: %w", ser.Name(), err)
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// testCorruption applies every damage to the encoding of every user for a single serializer
+func (r *Runner) testCorruption(ser serializers.Serializer) (serializers.CorruptionResult, error) {
+	encoded := make([][]byte, len(r.users))
+	decoded := make([]models.User, len(r.users))
+	for i, user := range r.users {
+		data, err := ser.Marshal(user)
+		if err != nil {
+			return serializers.CorruptionResult{}, fmt.Errorf("marshal of user %d failed: %w", user.ID, err)
+		}
+		if decoded[i], err = ser.Unmarshal(data); err != nil {
+			return serializers.CorruptionResult{}, fmt.Errorf("unmarshal of user %d failed: %w", user.ID, err)
+		}
+		encoded[i] = data
+	}
+
+	result := serializers.CorruptionResult{SerializerName: ser.Name()}
+	for _, d := range damages() {
+		rng := rand.New(rand.NewSource(1)) // same random damage sequence for every serializer
+		counts := serializers.CorruptionCounts{Damage: d.name}
+		for i, data := range encoded {
+			other := encoded[(i+1)%len(encoded)]
+			restored, panicked, err := unmarshalDamaged(ser, d.apply(rng, data, other))
+
+			counts.Trials++
+			switch {
+			case panicked || errors.As(err, new(serializers.PanicError)):
+				counts.Panics++
+			case err != nil:
+				counts.Errors++
+			case reflect.DeepEqual(restored, decoded[i]):
+				counts.Correct++
+			default:
+				counts.Wrong++
+			}
+		}
+		result.Damages = append(result.Damages, counts)
+	}
+
+	return result, nil
+}
+
+// unmarshalDamaged decodes damaged data, recovering from a panic of the decoder. Panics the
+// serializer recovered itself are returned as a serializers.PanicError instead.
+func unmarshalDamaged(ser serializers.Serializer, data []byte) (user models.User, panicked bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			user, panicked, err = models.User{}, true, fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	user, err = ser.Unmarshal(data)
+	return user, false, err
+}
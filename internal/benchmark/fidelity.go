@@ -0,0 +1,57 @@
+package benchmark
+
+import (
+	"fmt"
+
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/fidelity"
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/serializers"
+)
+
+// RunFidelityTests round-trips every user through each serializer with Marshal/Unmarshal and
+// compares the result with the original field by field
+func (r *Runner) RunFidelityTests() ([]serializers.FidelityResult, error) {
+	if len(r.users) == 0 {
+		return nil, fmt.Errorf("no test data provided")
+	}
+
+	results := make([]serializers.FidelityResult, 0, len(r.serializers))
+	for _, ser := range r.serializers {
+		fmt.Printf("Running fidelity test for %s...\n", ser.Name())
+		results = append(results, r.testFidelity(ser))
+	}
+
+	return results, nil
+}
+
+// testFidelity checks the round-trip fidelity of a single serializer
+func (r *Runner) testFidelity(ser serializers.Serializer) serializers.FidelityResult {
+	result := serializers.FidelityResult{
+		SerializerName: ser.Name(),
+		Users:          len(r.users),
+	}
+
+	fail := func(id int64, op string, err error) {
+		result.Errors++
+		if result.FirstError == "" {
+			result.FirstError = fmt.Sprintf("%s of user %d failed: %v", op, id, err)
+		}
+	}
+
+	checker := fidelity.NewChecker()
+	for _, user := range r.users {
+		data, err := ser.Marshal(user)
+		if err != nil {
+			fail(user.ID, "marshal", err)
+			continue
+		}
+		restored, err := ser.Unmarshal(data)
+		if err != nil {
+			fail(user.ID, "unmarshal", err)
+			continue
+		}
+		checker.Compare(user, restored)
+	}
+	result.Fields = checker.Fields()
+
+	return result
+}
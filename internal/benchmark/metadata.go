@@ -0,0 +1,196 @@
+package benchmark
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/models"
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/serializers"
+)
+
+// metadataProbeKey is the Metadata key holding the probed value
+const metadataProbeKey = "value"
+
+// metadataProbe is a value fed through User.Metadata
+type metadataProbe struct {
+	kind  string
+	value interface{}
+}
+
+// metadataProbes returns one value of every Go kind probed through Metadata. Integers use the
+// extreme values of their width so that conversions through float64 or narrower types show up.
+func metadataProbes() []metadataProbe {
+	return []metadataProbe{
+		{"int", int(math.MaxInt64)},
+		{"int8", int8(math.MinInt8)},
+		{"int16", int16(math.MinInt16)},
+		{"int32", int32(math.MinInt32)},
+		{"int64", int64(math.MinInt64)},
+		{"uint", uint(math.MaxUint64)},
+		{"uint8", uint8(math.MaxUint8)},
+		{"uint16", uint16(math.MaxUint16)},
+		{"uint32", uint32(math.MaxUint32)},
+		{"uint64", uint64(math.MaxUint64)},
+		{"small int", 42},
+		{"float32", float32(3.14)},
+		{"float64", math.Pi},
+		{"bool", true},
+		{"string", "text"},
+		{"nil", nil},
+		{"[]byte", []byte{0x00, 0x01, 0xfe, 0xff}},
+		{"time.Time", time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)},
+		{"slice", []interface{}{"a", 1, true}},
+		{"nested map", map[string]interface{}{"key": "value", "count": 1}},
+	}
+}
+
+// RunMetadataTypeTests feeds a value of every Go kind through User.Metadata and records the
+// type each serializer restores it as
+func (r *Runner) RunMetadataTypeTests() ([]serializers.MetadataTypeResult, error) {
+	results := make([]serializers.MetadataTypeResult, 0, len(r.serializers))
+
+	for _, ser := range r.serializers {
+		fmt.Printf("Running metadata type test for %s...\n", ser.Name())
+		result := serializers.MetadataTypeResult{SerializerName: ser.Name()}
+		for _, probe := range metadataProbes() {
+			result.Checks = append(result.Checks, checkMetadataType(ser, probe))
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// checkMetadataType round-trips a user whose Metadata holds only the probed value
+func checkMetadataType(ser serializers.Serializer, probe metadataProbe) serializers.MetadataTypeCheck {
+	check := serializers.MetadataTypeCheck{
+		Kind:         probe.kind,
+		OriginalType: typeName(probe.value),
+	}
+
+	user := models.User{
+		ID:        1,
+		Name:      "Metadata",
+		Email:     "metadata@example.com",
+		Metadata:  map[string]interface{}{metadataProbeKey: probe.value},
+		CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	data, err := ser.Marshal(user)
+	if err != nil {
+		check.Outcome = serializers.MetadataError
+		check.Detail = fmt.Sprintf("marshal: %v", err)
+		return check
+	}
+	restored, err := ser.Unmarshal(data)
+	if err != nil {
+		check.Outcome = serializers.MetadataError
+		check.Detail = fmt.Sprintf("unmarshal: %v", err)
+		return check
+	}
+
+	value, ok := restored.Metadata[metadataProbeKey]
+	if !ok {
+		check.Outcome = serializers.MetadataDropped
+		return check
+	}
+	check.RestoredType = typeName(value)
+	check.Detail = fmt.Sprintf("%#v", value)
+
+	switch {
+	case reflect.DeepEqual(probe.value, value):
+		check.Outcome = serializers.MetadataExact
+	case sameValue(probe.value, value):
+		check.Outcome = serializers.MetadataConverted
+	default:
+		check.Outcome = serializers.MetadataLossy
+	}
+	return check
+}
+
+// sameValue reports whether a and b represent the same value, possibly with different Go
+// types: numbers are compared exactly across integer and float types, and slices and maps
+// element by element
+func sameValue(a, b interface{}) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	if x, ok := exactNumber(a); ok {
+		y, ok := exactNumber(b)
+		return ok && x.Cmp(y) == 0
+	}
+
+	switch x := a.(type) {
+	case []byte:
+		y, ok := b.([]byte)
+		return ok && bytes.Equal(x, y)
+	case time.Time:
+		y, ok := b.(time.Time)
+		return ok && x.Equal(y)
+	}
+
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	switch va.Kind() {
+	case reflect.Slice, reflect.Array:
+		if vb.Kind() != reflect.Slice && vb.Kind() != reflect.Array || va.Len() != vb.Len() {
+			return false
+		}
+		for i := 0; i < va.Len(); i++ {
+			if !sameValue(va.Index(i).Interface(), vb.Index(i).Interface()) {
+				return false
+			}
+		}
+		return true
+
+	case reflect.Map:
+		if vb.Kind() != reflect.Map || va.Len() != vb.Len() {
+			return false
+		}
+		restored := make(map[string]interface{}, vb.Len())
+		for _, key := range vb.MapKeys() {
+			restored[fmt.Sprint(key.Interface())] = vb.MapIndex(key).Interface()
+		}
+		for _, key := range va.MapKeys() {
+			value, ok := restored[fmt.Sprint(key.Interface())]
+			if !ok || !sameValue(va.MapIndex(key).Interface(), value) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return va.Kind() == vb.Kind() && va.Type().ConvertibleTo(vb.Type()) &&
+		reflect.DeepEqual(va.Convert(vb.Type()).Interface(), b)
+}
+
+// exactNumber returns the exact value of an integer or float
+func exactNumber(v interface{}) (*big.Float, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Float).SetInt64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Float).SetUint64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		if math.IsNaN(f) {
+			return nil, false
+		}
+		return big.NewFloat(f), true
+	}
+	return nil, false
+}
+
+// typeName returns a short name of the dynamic type of v ("nil" for nil)
+func typeName(v interface{}) string {
+	if v == nil {
+		return "nil"
+	}
+	return strings.ReplaceAll(reflect.TypeOf(v).String(), "interface {}", "any")
+}
@@ -0,0 +1,62 @@
+package benchmark
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Mode selects which kind of serialization benchmark the runner executes
+type Mode string
+
+const (
+	// ModeSlice measures MarshalUsers/UnmarshalUsers on the entire users slice
+	ModeSlice Mode = "slice"
+	// ModePerRecord measures Marshal/Unmarshal for each user individually
+	ModePerRecord Mode = "per-record"
+	// ModeCompression analyzes how well the MarshalUsers output of each serializer compresses
+	ModeCompression Mode = "compression"
+	// ModeStream encodes and decodes generated users one by one through a temporary file
+	ModeStream Mode = "stream"
+	// ModeReuse compares the buffer-reusing AppendMarshal/UnmarshalInto APIs with per-call allocation
+	ModeReuse Mode = "reuse"
+	// ModePartial compares reading selected fields with ReadFields against a full Unmarshal
+	ModePartial Mode = "partial"
+	// ModeConcurrency measures per-record throughput from several goroutines at once
+	ModeConcurrency Mode = "concurrency"
+	// ModeFidelity round-trips every user and checks which field paths survive unchanged
+	ModeFidelity Mode = "fidelity"
+	// ModeCorruption damages valid encodings and classifies how Unmarshal handles them
+	ModeCorruption Mode = "corruption"
+)
+
+// allModes lists every supported mode in execution order
+var allModes = []Mode{ModeSlice, ModePerRecord, ModeCompression, ModeStream, ModeReuse, ModePartial, ModeConcurrency, ModeFidelity, ModeCorruption}
+
+// ParseModes parses a comma-separated list of modes ("all" selects every mode)
+func ParseModes(s string) ([]Mode, error) {
+	var modes []Mode
+
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if part == "all" {
+			return append([]Mode(nil), allModes...), nil
+		}
+
+		mode := Mode(part)
+		if !slices.Contains(allModes, mode) {
+			return nil, fmt.Errorf("unknown benchmark mode %q", part)
+		}
+		if !slices.Contains(modes, mode) {
+			modes = append(modes, mode)
+		}
+	}
+
+	if len(modes) == 0 {
+		return nil, fmt.Errorf("no benchmark mode specified")
+	}
+	return modes, nil
+}
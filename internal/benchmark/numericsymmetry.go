@@ -0,0 +1,92 @@
+package benchmark
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/models"
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/serializers"
+)
+
+// float64IntegerLimit is 2^53, above which float64 cannot represent every integer
+const float64IntegerLimit = 1 << 53
+
+// numericProbe is a user with boundary numbers and a comparison of the numbers it carries
+type numericProbe struct {
+	name    string
+	user    models.User
+	compare func(original, restored models.User) string // mismatch description, "" if equal
+}
+
+// testNumericSymmetry round-trips users with numbers at the boundaries of int64, int32 and the
+// float64 integer range and records in result whether each category was preserved, rejected
+// with an OverflowError or silently lost
+func testNumericSymmetry(ser serializers.Serializer, result *serializers.SymmetryResult) {
+	compareID := func(original, restored models.User) string {
+		if original.ID != restored.ID {
+			return fmt.Sprintf("ID original=%d, restored=%d", original.ID, restored.ID)
+		}
+		return ""
+	}
+
+	result.NumericInt64 = checkNumeric(ser, result, []numericProbe{
+		{"max int64 ID", models.User{ID: math.MaxInt64, Name: "Test6"}, compareID},
+		{"min int64 ID", models.User{ID: math.MinInt64, Name: "Test6"}, compareID},
+	})
+
+	result.NumericInt32 = checkNumeric(ser, result, []numericProbe{
+		{"Age beyond int32", models.User{ID: 7, Name: "Test7", Age: math.MaxInt32 + 1}, func(original, restored models.User) string {
+			if original.Age != restored.Age {
+				return fmt.Sprintf("Age original=%d, restored=%d", original.Age, restored.Age)
+			}
+			return ""
+		}},
+		{"Limits beyond int32", models.User{ID: 7, Name: "Test7", Settings: models.Settings{
+			Limits: map[string]int{"max_storage": 1 << 40, "min_balance": math.MinInt32 - 1},
+		}}, func(original, restored models.User) string {
+			if !reflect.DeepEqual(original.Settings.Limits, restored.Settings.Limits) {
+				return fmt.Sprintf("Limits original=%v, restored=%v", original.Settings.Limits, restored.Settings.Limits)
+			}
+			return ""
+		}},
+	})
+
+	result.NumericFloat53 = checkNumeric(ser, result, []numericProbe{
+		{"ID 2^53+1", models.User{ID: float64IntegerLimit + 1, Name: "Test8"}, compareID},
+		{"Metadata 2^53+1", models.User{ID: 8, Name: "Test8", Metadata: map[string]interface{}{"id": float64IntegerLimit + 1}},
+			func(original, restored models.User) string {
+				if !sameValue(original.Metadata["id"], restored.Metadata["id"]) {
+					return fmt.Sprintf("Metadata.id original=%v, restored=%v (%s)",
+						original.Metadata["id"], restored.Metadata["id"], typeName(restored.Metadata["id"]))
+				}
+				return ""
+			}},
+	})
+}
+
+// checkNumeric round-trips the probes of a category and returns the outcome of the first one
+// that was not preserved, adding a description of it to result.Details
+func checkNumeric(ser serializers.Serializer, result *serializers.SymmetryResult, probes []numericProbe) string {
+	for _, probe := range probes {
+		data, err := ser.Marshal(probe.user)
+		if err != nil {
+			result.Details += fmt.Sprintf("Numeric %s marshal error: %v; ", probe.name, err)
+			if errors.As(err, new(serializers.OverflowError)) {
+				return serializers.NumericRejected
+			}
+			return serializers.NumericError
+		}
+		restored, err := ser.Unmarshal(data)
+		if err != nil {
+			result.Details += fmt.Sprintf("Numeric %s unmarshal error: %v; ", probe.name, err)
+			return serializers.NumericError
+		}
+		if mismatch := probe.compare(probe.user, restored); mismatch != "" {
+			result.Details += fmt.Sprintf("Numeric %s lost: %s; ", probe.name, mismatch)
+			return serializers.NumericLost
+		}
+	}
+	return serializers.NumericPreserved
+}
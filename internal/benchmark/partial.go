@@ -0,0 +1,121 @@
+package benchmark
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/serializers"
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/utils"
+)
+
+// RunPartialBenchmarks compares reading only fields from each encoded user with ReadFields
+// against a full per-record Unmarshal, for every serializer implementing PartialReader
+func (r *Runner) RunPartialBenchmarks(iterations int, fields []serializers.Field) ([]serializers.PartialResult, error) {
+	if len(r.users) == 0 {
+		return nil, fmt.Errorf("no test data provided")
+	}
+
+	var results []serializers.PartialResult
+	for _, ser := range r.serializers {
+		reader, ok := ser.(serializers.PartialReader)
+		if !ok {
+			fmt.Printf("Skipping partial read benchmark for %s (no ReadFields)\n", ser.Name())
+			continue
+		}
+
+		fmt.Printf("Running partial read benchmark for %s...\n", ser.Name())
+		result, err := r.benchmarkPartial(ser, reader, fields, iterations)
+		if err != nil {
+			return nil, fmt.Errorf("error benchmarking partial reads of %s: %w", ser.Name(), err)
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// benchmarkPartial runs the per-record benchmark of a serializer and measures ReadFields of
+// the same encoded users after checking that it reads the values Unmarshal decodes
+func (r *Runner) benchmarkPartial(ser serializers.Serializer, reader serializers.PartialReader, fields []serializers.Field, iterations int) (serializers.PartialResult, error) {
+	result := serializers.PartialResult{
+		SerializerName: ser.Name(),
+		RecordCount:    len(r.users),
+	}
+	for _, field := range fields {
+		result.Fields = append(result.Fields, field.String())
+	}
+
+	encoded := make([][]byte, len(r.users))
+	if _, err := r.measurePerRecord(ser, encoded); err != nil {
+		return result, err
+	}
+	values := make([]serializers.FieldValue, len(fields))
+	if err := checkPartial(ser, reader, fields, encoded, values); err != nil {
+		return result, err
+	}
+
+	for i := 0; i < r.warmup; i++ {
+		if _, err := r.measurePerRecord(ser, encoded); err != nil {
+			return result, fmt.Errorf("warmup iteration %d failed: %w", i+1, err)
+		}
+		if _, _, err := r.measurePartial(reader, fields, encoded, values); err != nil {
+			return result, fmt.Errorf("warmup iteration %d failed: %w", i+1, err)
+		}
+	}
+
+	unmarshalTimes := make([]int64, iterations)
+	readTimes := make([]int64, iterations)
+	unmarshalAllocs := make([]utils.AllocStats, iterations)
+	readAllocs := make([]utils.AllocStats, iterations)
+	for i := 0; i < iterations; i++ {
+		base, err := r.measurePerRecord(ser, encoded)
+		if err != nil {
+			return result, fmt.Errorf("iteration %d failed: %w", i+1, err)
+		}
+		unmarshalTimes[i], unmarshalAllocs[i] = base.unmarshalTime, base.unmarshalAlloc
+		if readTimes[i], readAllocs[i], err = r.measurePartial(reader, fields, encoded, values); err != nil {
+			return result, fmt.Errorf("iteration %d failed: %w", i+1, err)
+		}
+	}
+
+	count := int64(len(r.users))
+	result.UnmarshalNsPerOp = utils.CalculateMedian(unmarshalTimes) / count
+	result.ReadNsPerOp = utils.CalculateMedian(readTimes) / count
+	result.UnmarshalAllocAvg = utils.AverageAllocStats(unmarshalAllocs)
+	result.ReadAllocAvg = utils.AverageAllocStats(readAllocs)
+
+	return result, nil
+}
+
+// checkPartial checks that ReadFields of every encoded user returns the field values of the
+// user Unmarshal decodes, so the benchmark never compares against a reader that skips work
+func checkPartial(ser serializers.Serializer, reader serializers.PartialReader, fields []serializers.Field, encoded [][]byte, values []serializers.FieldValue) error {
+	for _, data := range encoded {
+		user, err := ser.Unmarshal(data)
+		if err != nil {
+			return fmt.Errorf("unmarshal failed: %w", err)
+		}
+		if err := reader.ReadFields(data, fields, values); err != nil {
+			return fmt.Errorf("read fields failed for user %d: %w", user.ID, err)
+		}
+		for i, field := range fields {
+			if want := field.Value(user); !values[i].Equal(want) {
+				return fmt.Errorf("user %d: ReadFields read %s = %+v, Unmarshal decoded %+v", user.ID, field, values[i], want)
+			}
+		}
+	}
+	return nil
+}
+
+// measurePartial measures ReadFields of fields from every encoded user into values
+func (r *Runner) measurePartial(reader serializers.PartialReader, fields []serializers.Field, encoded [][]byte, values []serializers.FieldValue) (int64, utils.AllocStats, error) {
+	before := utils.TakeMemSnapshot()
+	start := time.Now()
+	for i, data := range encoded {
+		if err := reader.ReadFields(data, fields, values); err != nil {
+			return 0, utils.AllocStats{}, fmt.Errorf("read fields failed for user %d: %w", r.users[i].ID, err)
+		}
+	}
+	elapsed := time.Since(start).Nanoseconds()
+	return elapsed, utils.TakeMemSnapshot().Since(before), nil
+}
@@ -0,0 +1,134 @@
+package benchmark
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/models"
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/serializers"
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/utils"
+)
+
+// RunReuseBenchmarks compares the buffer-reusing AppendMarshal/UnmarshalInto APIs against
+// allocate-per-call Marshal/Unmarshal for every serializer implementing at least one of them
+func (r *Runner) RunReuseBenchmarks(iterations int) ([]serializers.ReuseResult, error) {
+	if len(r.users) == 0 {
+		return nil, fmt.Errorf("no test data provided")
+	}
+
+	var results []serializers.ReuseResult
+	for _, ser := range r.serializers {
+		am, hasAppend := ser.(serializers.AppendMarshaler)
+		ui, hasInto := ser.(serializers.IntoUnmarshaler)
+		if !hasAppend && !hasInto {
+			fmt.Printf("Skipping reuse benchmark for %s (no AppendMarshal or UnmarshalInto)\n", ser.Name())
+			continue
+		}
+
+		fmt.Printf("Running reuse benchmark for %s...\n", ser.Name())
+		result, err := r.benchmarkReuse(ser, am, ui, iterations)
+		if err != nil {
+			return nil, fmt.Errorf("error benchmarking reuse of %s: %w", ser.Name(), err)
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// benchmarkReuse runs the per-record benchmark of a serializer once with allocate-per-call
+// and once with the reuse APIs it implements (am or ui may be nil)
+func (r *Runner) benchmarkReuse(ser serializers.Serializer, am serializers.AppendMarshaler, ui serializers.IntoUnmarshaler, iterations int) (serializers.ReuseResult, error) {
+	result := serializers.ReuseResult{
+		SerializerName: ser.Name(),
+		RecordCount:    len(r.users),
+		AppendMarshal:  am != nil,
+		UnmarshalInto:  ui != nil,
+	}
+
+	var buf []byte
+	encoded := make([][]byte, len(r.users))
+	for i := 0; i < r.warmup; i++ {
+		if _, err := r.measurePerRecord(ser, encoded); err != nil {
+			return result, fmt.Errorf("warmup iteration %d failed: %w", i+1, err)
+		}
+		if _, err := r.measureReuse(am, ui, encoded, &buf); err != nil {
+			return result, fmt.Errorf("warmup iteration %d failed: %w", i+1, err)
+		}
+	}
+
+	marshalTimes := make([]int64, iterations)
+	unmarshalTimes := make([]int64, iterations)
+	appendTimes := make([]int64, iterations)
+	intoTimes := make([]int64, iterations)
+	marshalAllocs := make([]utils.AllocStats, iterations)
+	unmarshalAllocs := make([]utils.AllocStats, iterations)
+	appendAllocs := make([]utils.AllocStats, iterations)
+	intoAllocs := make([]utils.AllocStats, iterations)
+	for i := 0; i < iterations; i++ {
+		base, err := r.measurePerRecord(ser, encoded)
+		if err != nil {
+			return result, fmt.Errorf("iteration %d failed: %w", i+1, err)
+		}
+		reuse, err := r.measureReuse(am, ui, encoded, &buf)
+		if err != nil {
+			return result, fmt.Errorf("iteration %d failed: %w", i+1, err)
+		}
+		marshalTimes[i], marshalAllocs[i] = base.marshalTime, base.marshalAlloc
+		unmarshalTimes[i], unmarshalAllocs[i] = base.unmarshalTime, base.unmarshalAlloc
+		appendTimes[i], appendAllocs[i] = reuse.marshalTime, reuse.marshalAlloc
+		intoTimes[i], intoAllocs[i] = reuse.unmarshalTime, reuse.unmarshalAlloc
+	}
+
+	count := int64(len(r.users))
+	result.MarshalNsPerOp = utils.CalculateMedian(marshalTimes) / count
+	result.UnmarshalNsPerOp = utils.CalculateMedian(unmarshalTimes) / count
+	result.MarshalAllocAvg = utils.AverageAllocStats(marshalAllocs)
+	result.UnmarshalAllocAvg = utils.AverageAllocStats(unmarshalAllocs)
+	if am != nil {
+		result.AppendNsPerOp = utils.CalculateMedian(appendTimes) / count
+		result.AppendAllocAvg = utils.AverageAllocStats(appendAllocs)
+	}
+	if ui != nil {
+		result.IntoNsPerOp = utils.CalculateMedian(intoTimes) / count
+		result.IntoAllocAvg = utils.AverageAllocStats(intoAllocs)
+	}
+
+	return result, nil
+}
+
+// measureReuse measures AppendMarshal of all users into the single buffer *buf, truncated
+// before each record, and UnmarshalInto of the encoded users into a single user. Phases whose
+// interface is not implemented (am or ui is nil) are skipped.
+func (r *Runner) measureReuse(am serializers.AppendMarshaler, ui serializers.IntoUnmarshaler, encoded [][]byte, buf *[]byte) (iterationResult, error) {
+	var iter iterationResult
+	var err error
+
+	if am != nil {
+		before := utils.TakeMemSnapshot()
+		start := time.Now()
+		for _, user := range r.users {
+			*buf, err = am.AppendMarshal((*buf)[:0], user)
+			if err != nil {
+				return iter, fmt.Errorf("append marshal failed for user %d: %w", user.ID, err)
+			}
+		}
+		iter.marshalTime = time.Since(start).Nanoseconds()
+		iter.marshalAlloc = utils.TakeMemSnapshot().Since(before)
+	}
+
+	if ui != nil {
+		var user models.User
+		before := utils.TakeMemSnapshot()
+		start := time.Now()
+		for i, data := range encoded {
+			if err = ui.UnmarshalInto(data, &user); err != nil {
+				return iter, fmt.Errorf("unmarshal into failed for user %d: %w", r.users[i].ID, err)
+			}
+		}
+		iter.unmarshalTime = time.Since(start).Nanoseconds()
+		iter.unmarshalAlloc = utils.TakeMemSnapshot().Since(before)
+	}
+
+	return iter, nil
+}
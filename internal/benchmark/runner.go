@@ -2,7 +2,9 @@ package benchmark
 
 import (
 	"fmt"
+	"math/rand"
 	"reflect"
+	"slices"
 	"time"
 
 	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/models"
@@ -10,15 +12,34 @@ import (
 	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/utils"
 )
 
+const (
+	// medianCIConfidence is the confidence level of bootstrap median intervals
+	medianCIConfidence = 0.95
+	// medianCIResamples is the number of bootstrap resamples per interval
+	medianCIResamples = 1000
+)
+
 // Runner handles the execution of serialization benchmarks
 type Runner struct {
 	users       models.Users
 	serializers []serializers.Serializer
+	modes       []Mode
+	warmup      int
+}
+
+// iterationResult holds the measurements of a single benchmark iteration
+type iterationResult struct {
+	marshalTime    int64 // nanoseconds
+	unmarshalTime  int64 // nanoseconds
+	marshalAlloc   utils.AllocStats
+	unmarshalAlloc utils.AllocStats
 }
 
 // NewRunner creates a new benchmark runner
 func NewRunner() *Runner {
-	return &Runner{}
+	return &Runner{
+		modes: []Mode{ModeSlice},
+	}
 }
 
 // SetTestData sets the test data for benchmarking
@@ -26,6 +47,21 @@ func (r *Runner) SetTestData(users models.Users) {
 	r.users = users
 }
 
+// SetModes sets the benchmark modes to execute
+func (r *Runner) SetModes(modes []Mode) {
+	r.modes = modes
+}
+
+// HasMode reports whether the given benchmark mode is selected
+func (r *Runner) HasMode(mode Mode) bool {
+	return slices.Contains(r.modes, mode)
+}
+
+// SetWarmup sets the number of untimed warmup iterations run before measuring
+func (r *Runner) SetWarmup(iterations int) {
+	r.warmup = iterations
+}
+
 // AddSerializer adds a serializer to be benchmarked
 func (r *Runner) AddSerializer(s serializers.Serializer) {
 	r.serializers = append(r.serializers, s)
@@ -54,9 +90,11 @@ func (r *Runner) RunBenchmarks(iterations int) ([]serializers.SerializationResul
 // benchmarkSerializer runs benchmark for a single serializer
 func (r *Runner) benchmarkSerializer(ser serializers.Serializer, iterations int) (serializers.SerializationResult, error) {
 	result := serializers.SerializationResult{
-		SerializerName: ser.Name(),
-		MarshalTimes:   make([]int64, iterations),
-		UnmarshalTimes: make([]int64, iterations),
+		SerializerName:      ser.Name(),
+		MarshalTimes:        make([]int64, iterations),
+		UnmarshalTimes:      make([]int64, iterations),
+		MarshalAllocStats:   make([]utils.AllocStats, iterations),
+		UnmarshalAllocStats: make([]utils.AllocStats, iterations),
 	}
 
 	// Calculate data size for the entire users slice
@@ -66,14 +104,23 @@ func (r *Runner) benchmarkSerializer(ser serializers.Serializer, iterations int)
 	}
 	result.DataSize = len(data)
 
+	// Run warmup iterations - results are discarded
+	for i := 0; i < r.warmup; i++ {
+		if _, err := r.measureUsersSlice(ser); err != nil {
+			return result, fmt.Errorf("warmup iteration %d failed: %w", i+1, err)
+		}
+	}
+
 	// Run iterations - process entire users slice in each iteration
 	for i := 0; i < iterations; i++ {
-		marshalTime, unmarshalTime, err := r.measureUsersSlice(ser)
+		iter, err := r.measureUsersSlice(ser)
 		if err != nil {
 			return result, fmt.Errorf("iteration %d failed: %w", i+1, err)
 		}
-		result.MarshalTimes[i] = marshalTime
-		result.UnmarshalTimes[i] = unmarshalTime
+		result.MarshalTimes[i] = iter.marshalTime
+		result.UnmarshalTimes[i] = iter.unmarshalTime
+		result.MarshalAllocStats[i] = iter.marshalAlloc
+		result.UnmarshalAllocStats[i] = iter.unmarshalAlloc
 	}
 
 	// Calculate statistics
@@ -81,32 +128,165 @@ func (r *Runner) benchmarkSerializer(ser serializers.Serializer, iterations int)
 	result.MarshalMedianNs = utils.CalculateMedian(result.MarshalTimes)
 	result.UnmarshalAvgNs = utils.CalculateAverage(result.UnmarshalTimes)
 	result.UnmarshalMedianNs = utils.CalculateMedian(result.UnmarshalTimes)
+	result.MarshalStats = utils.Summarize(result.MarshalTimes)
+	result.UnmarshalStats = utils.Summarize(result.UnmarshalTimes)
+	rng := rand.New(rand.NewSource(1)) // fixed source keeps intervals reproducible
+	result.MarshalMedianCI = utils.BootstrapMedianCI(result.MarshalTimes, medianCIConfidence, medianCIResamples, rng)
+	result.UnmarshalMedianCI = utils.BootstrapMedianCI(result.UnmarshalTimes, medianCIConfidence, medianCIResamples, rng)
+	result.MarshalAllocAvg = utils.AverageAllocStats(result.MarshalAllocStats)
+	result.UnmarshalAllocAvg = utils.AverageAllocStats(result.UnmarshalAllocStats)
 
 	return result, nil
 }
 
-// measureUsersSlice measures marshal and unmarshal time for the entire users slice
-func (r *Runner) measureUsersSlice(ser serializers.Serializer) (marshalTime, unmarshalTime int64, err error) {
+// measureUsersSlice measures marshal and unmarshal time and allocations for the entire users slice
+func (r *Runner) measureUsersSlice(ser serializers.Serializer) (iterationResult, error) {
+	var iter iterationResult
+
 	// Measure marshal time for entire users slice
+	before := utils.TakeMemSnapshot()
 	start := time.Now()
 	data, err := ser.MarshalUsers(r.users)
-	marshalTime = time.Since(start).Nanoseconds()
+	iter.marshalTime = time.Since(start).Nanoseconds()
+	iter.marshalAlloc = utils.TakeMemSnapshot().Since(before)
 	if err != nil {
-		return 0, 0, fmt.Errorf("marshal failed for users slice: %w", err)
+		return iter, fmt.Errorf("marshal failed for users slice: %w", err)
 	}
 
 	// Measure unmarshal time for entire users slice
+	before = utils.TakeMemSnapshot()
 	start = time.Now()
 	_, err = ser.UnmarshalUsers(data)
-	unmarshalTime = time.Since(start).Nanoseconds()
+	iter.unmarshalTime = time.Since(start).Nanoseconds()
+	iter.unmarshalAlloc = utils.TakeMemSnapshot().Since(before)
 	if err != nil {
-		return 0, 0, fmt.Errorf("unmarshal failed for users slice: %w", err)
+		return iter, fmt.Errorf("unmarshal failed for users slice: %w", err)
 	}
 
-	return marshalTime, unmarshalTime, nil
+	return iter, nil
 }
 
-// RunSymmetryTests checks how empty slices and maps are handled
+// RunPerRecordBenchmarks executes per-record benchmarks for all serializers
+func (r *Runner) RunPerRecordBenchmarks(iterations int) ([]serializers.PerRecordResult, error) {
+	if len(r.users) == 0 {
+		return nil, fmt.Errorf("no test data provided")
+	}
+
+	results := make([]serializers.PerRecordResult, 0, len(r.serializers))
+
+	for _, ser := range r.serializers {
+		fmt.Printf("Running per-record benchmark for %s...\n", ser.Name())
+		result, err := r.benchmarkSerializerPerRecord(ser, iterations)
+		if err != nil {
+			return nil, fmt.Errorf("error benchmarking %s per record: %w", ser.Name(), err)
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// benchmarkSerializerPerRecord runs the per-record benchmark for a single serializer
+func (r *Runner) benchmarkSerializerPerRecord(ser serializers.Serializer, iterations int) (serializers.PerRecordResult, error) {
+	result := serializers.PerRecordResult{
+		SerializerName: ser.Name(),
+		RecordCount:    len(r.users),
+		MarshalTimes:   make([]int64, iterations),
+		UnmarshalTimes: make([]int64, iterations),
+	}
+
+	// Calculate the size distribution of individually serialized users
+	sizes := make([]int64, len(r.users))
+	for i, user := range r.users {
+		data, err := ser.Marshal(user)
+		if err != nil {
+			return result, fmt.Errorf("initial marshal failed for user %d: %w", user.ID, err)
+		}
+		sizes[i] = int64(len(data))
+	}
+	result.SizeMin = int(slices.Min(sizes))
+	result.SizeAvg = int(utils.CalculateAverage(sizes))
+	result.SizeP50 = int(utils.CalculatePercentile(sizes, 50))
+	result.SizeP99 = int(utils.CalculatePercentile(sizes, 99))
+	result.SizeMax = int(slices.Max(sizes))
+
+	// Run warmup iterations - results are discarded
+	encoded := make([][]byte, len(r.users))
+	for i := 0; i < r.warmup; i++ {
+		if _, err := r.measurePerRecord(ser, encoded); err != nil {
+			return result, fmt.Errorf("warmup iteration %d failed: %w", i+1, err)
+		}
+	}
+
+	// Run iterations - process every user individually in each iteration
+	marshalAllocs := make([]utils.AllocStats, iterations)
+	unmarshalAllocs := make([]utils.AllocStats, iterations)
+	for i := 0; i < iterations; i++ {
+		iter, err := r.measurePerRecord(ser, encoded)
+		if err != nil {
+			return result, fmt.Errorf("iteration %d failed: %w", i+1, err)
+		}
+		result.MarshalTimes[i] = iter.marshalTime
+		result.UnmarshalTimes[i] = iter.unmarshalTime
+		marshalAllocs[i] = iter.marshalAlloc
+		unmarshalAllocs[i] = iter.unmarshalAlloc
+	}
+
+	// Calculate per-record statistics from the median iteration time
+	count := int64(len(r.users))
+	marshalMedian := utils.CalculateMedian(result.MarshalTimes)
+	unmarshalMedian := utils.CalculateMedian(result.UnmarshalTimes)
+	result.MarshalNsPerOp = marshalMedian / count
+	result.UnmarshalNsPerOp = unmarshalMedian / count
+	result.MarshalRecordsPerSec = recordsPerSecond(count, marshalMedian)
+	result.UnmarshalRecordsPerSec = recordsPerSecond(count, unmarshalMedian)
+	result.MarshalAllocAvg = utils.AverageAllocStats(marshalAllocs)
+	result.UnmarshalAllocAvg = utils.AverageAllocStats(unmarshalAllocs)
+
+	return result, nil
+}
+
+// measurePerRecord measures the total marshal and unmarshal time and allocations for all users processed one by one
+func (r *Runner) measurePerRecord(ser serializers.Serializer, encoded [][]byte) (iterationResult, error) {
+	var iter iterationResult
+	var err error
+
+	// Measure marshal time for each user individually
+	before := utils.TakeMemSnapshot()
+	start := time.Now()
+	for i, user := range r.users {
+		encoded[i], err = ser.Marshal(user)
+		if err != nil {
+			return iter, fmt.Errorf("marshal failed for user %d: %w", user.ID, err)
+		}
+	}
+	iter.marshalTime = time.Since(start).Nanoseconds()
+	iter.marshalAlloc = utils.TakeMemSnapshot().Since(before)
+
+	// Measure unmarshal time for each user individually
+	before = utils.TakeMemSnapshot()
+	start = time.Now()
+	for i, data := range encoded {
+		if _, err = ser.Unmarshal(data); err != nil {
+			return iter, fmt.Errorf("unmarshal failed for user %d: %w", r.users[i].ID, err)
+		}
+	}
+	iter.unmarshalTime = time.Since(start).Nanoseconds()
+	iter.unmarshalAlloc = utils.TakeMemSnapshot().Since(before)
+
+	return iter, nil
+}
+
+// recordsPerSecond converts a total duration for count records into throughput
+func recordsPerSecond(count, totalNs int64) float64 {
+	if totalNs <= 0 {
+		return 0
+	}
+	return float64(count) / (float64(totalNs) / float64(time.Second))
+}
+
+// RunSymmetryTests checks how empty slices and maps, time.Time values, boundary numbers and
+// hostile strings are handled
 func (r *Runner) RunSymmetryTests() ([]serializers.SymmetryResult, error) {
 	results := make([]serializers.SymmetryResult, 0, len(r.serializers))
 
@@ -325,6 +505,15 @@ func (r *Runner) testSymmetry(ser serializers.Serializer) serializers.SymmetryRe
 		}
 	}
 
+	// Test time.Time precision, location, zero value, range and equality
+	testTimeSymmetry(ser, &result)
+
+	// Test int64, int32 and float64 integer boundaries
+	testNumericSymmetry(ser, &result)
+
+	// Test multi-byte, invalid and HTML-sensitive strings
+	testStringSymmetry(ser, &result)
+
 	if result.Details == "" {
 		result.Details = "All tests passed"
 	}
@@ -0,0 +1,181 @@
+package benchmark
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"time"
+
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/models"
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/serializers"
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/utils"
+)
+
+const (
+	// streamBufferSize is the size of the buffered writer and reader around the stream file
+	streamBufferSize = 64 * 1024
+	// heapSampleInterval is how often the heap is sampled while streaming
+	heapSampleInterval = 5 * time.Millisecond
+)
+
+// StreamConfig describes the generated data streamed by RunStreamBenchmarks
+type StreamConfig struct {
+	Count   int                // number of users to stream
+	Seed    int64              // seed of the generated users
+	Profile models.DataProfile // shape of the generated users
+	Dir     string             // directory for the temporary stream file (default os.TempDir)
+}
+
+// RunStreamBenchmarks streams generated users through every serializer implementing
+// serializers.StreamSerializer. Users are generated on the fly and encoded one by one to a
+// temporary file, then decoded one by one from it, so the dataset never has to fit in memory.
+func (r *Runner) RunStreamBenchmarks(config StreamConfig, iterations int) ([]serializers.StreamResult, error) {
+	generationNs := measureGeneration(config, iterations)
+
+	var results []serializers.StreamResult
+	for _, ser := range r.serializers {
+		ss, ok := ser.(serializers.StreamSerializer)
+		if !ok {
+			fmt.Printf("Skipping stream benchmark for %s (no streaming support)\n", ser.Name())
+			continue
+		}
+
+		fmt.Printf("Running stream benchmark for %s...\n", ser.Name())
+		result, err := r.benchmarkStream(ss, config, iterations, generationNs)
+		if err != nil {
+			return nil, fmt.Errorf("error streaming %s: %w", ser.Name(), err)
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// measureGeneration returns the median time to generate the streamed users without encoding them
+func measureGeneration(config StreamConfig, iterations int) int64 {
+	times := make([]int64, iterations)
+	for i := range times {
+		gen := models.NewGenerator(config.Seed, config.Profile)
+		start := time.Now()
+		for j := 0; j < config.Count; j++ {
+			gen.Next()
+		}
+		times[i] = time.Since(start).Nanoseconds()
+	}
+	return utils.CalculateMedian(times)
+}
+
+// benchmarkStream benchmarks streaming for a single serializer
+func (r *Runner) benchmarkStream(ss serializers.StreamSerializer, config StreamConfig, iterations int, generationNs int64) (serializers.StreamResult, error) {
+	result := serializers.StreamResult{
+		SerializerName: ss.Name(),
+		RecordCount:    config.Count,
+		GenerationNs:   generationNs,
+		EncodeTimes:    make([]int64, iterations),
+		DecodeTimes:    make([]int64, iterations),
+	}
+
+	for i := 0; i < iterations; i++ {
+		file, err := os.CreateTemp(config.Dir, "serialization-stream-*")
+		if err != nil {
+			return result, fmt.Errorf("failed to create stream file: %w", err)
+		}
+
+		err = r.streamIteration(ss, config, file, &result, i)
+		file.Close()
+		os.Remove(file.Name())
+		if err != nil {
+			return result, err
+		}
+	}
+
+	result.EncodeMedianNs = utils.CalculateMedian(result.EncodeTimes)
+	result.DecodeMedianNs = utils.CalculateMedian(result.DecodeTimes)
+	result.EncodeRecordsPerSec = recordsPerSecond(int64(config.Count), result.EncodeMedianNs)
+	result.DecodeRecordsPerSec = recordsPerSecond(int64(config.Count), result.DecodeMedianNs)
+	result.EncodeMBPerSec = megabytesPerSecond(result.StreamSize, result.EncodeMedianNs)
+	result.DecodeMBPerSec = megabytesPerSecond(result.StreamSize, result.DecodeMedianNs)
+
+	return result, nil
+}
+
+// streamIteration encodes the generated users to file and decodes them again, recording the
+// times and peak heap growth of iteration i in result
+func (r *Runner) streamIteration(ss serializers.StreamSerializer, config StreamConfig, file *os.File, result *serializers.StreamResult, i int) error {
+	// Encode
+	gen := models.NewGenerator(config.Seed, config.Profile)
+	runtime.GC()
+	baseline := utils.HeapInUse()
+	sampler := utils.StartHeapSampler(heapSampleInterval)
+	start := time.Now()
+
+	writer := bufio.NewWriterSize(file, streamBufferSize)
+	enc := ss.NewStreamEncoder(writer)
+	for j := 0; j < config.Count; j++ {
+		if err := enc.Encode(gen.Next()); err != nil {
+			sampler.Stop()
+			return fmt.Errorf("encode of record %d failed: %w", j, err)
+		}
+	}
+	if err := enc.Flush(); err != nil {
+		sampler.Stop()
+		return fmt.Errorf("encoder flush failed: %w", err)
+	}
+	if err := writer.Flush(); err != nil {
+		sampler.Stop()
+		return fmt.Errorf("write failed: %w", err)
+	}
+
+	result.EncodeTimes[i] = max(time.Since(start).Nanoseconds()-result.GenerationNs, 0)
+	peak := sampler.Stop()
+	result.EncodePeakHeap = max(result.EncodePeakHeap, peak-min(baseline, peak))
+
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to stat stream file: %w", err)
+	}
+	result.StreamSize = info.Size()
+
+	// Decode
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("failed to rewind stream file: %w", err)
+	}
+	runtime.GC()
+	baseline = utils.HeapInUse()
+	sampler = utils.StartHeapSampler(heapSampleInterval)
+	start = time.Now()
+
+	dec := ss.NewStreamDecoder(bufio.NewReaderSize(file, streamBufferSize))
+	decoded := 0
+	for {
+		_, err := dec.Decode()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			sampler.Stop()
+			return fmt.Errorf("decode of record %d failed: %w", decoded, err)
+		}
+		decoded++
+	}
+
+	result.DecodeTimes[i] = time.Since(start).Nanoseconds()
+	peak = sampler.Stop()
+	result.DecodePeakHeap = max(result.DecodePeakHeap, peak-min(baseline, peak))
+
+	if decoded != config.Count {
+		return fmt.Errorf("decoded %d records, expected %d", decoded, config.Count)
+	}
+	return nil
+}
+
+// megabytesPerSecond returns the throughput of size bytes in totalNs nanoseconds in MB/s
+func megabytesPerSecond(size, totalNs int64) float64 {
+	if totalNs <= 0 {
+		return 0
+	}
+	return float64(size) / 1000000.0 / (float64(totalNs) / float64(time.Second))
+}
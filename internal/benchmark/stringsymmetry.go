@@ -0,0 +1,77 @@
+package benchmark
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/models"
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/serializers"
+)
+
+// longStringBytes is the minimum size of the long string probe
+const longStringBytes = 1 << 20
+
+// testStringSymmetry round-trips hostile strings as Name and records in result whether their
+// bytes were preserved, escaped in the encoding, replaced with U+FFFD, otherwise changed or
+// rejected with an error
+func testStringSymmetry(ser serializers.Serializer, result *serializers.SymmetryResult) {
+	// Compressed output never contains the string verbatim, so look at the innermost encoding
+	encoder := ser
+	for {
+		w, ok := encoder.(serializers.Wrapper)
+		if !ok {
+			break
+		}
+		encoder = w.Inner()
+	}
+
+	result.StringMultiByte = checkString(ser, encoder, result, "multi-byte", "山田 太郎（ヤマダ タロウ）様 — Ünïcödé")
+	result.StringEmojiZWJ = checkString(ser, encoder, result, "emoji ZWJ", "👨\u200d👩\u200d👧\u200d👦 👩🏽\u200d💻 🏳️\u200d🌈")
+	result.StringInvalidUTF8 = checkString(ser, encoder, result, "invalid UTF-8", "valid\xff\xfeinvalid\xc3")
+	result.StringNUL = checkString(ser, encoder, result, "NUL", "before\x00after")
+	result.StringHTML = checkString(ser, encoder, result, "HTML", "<b>Tom & Jerry</b>")
+	result.StringLong = checkString(ser, encoder, result, "long",
+		strings.Repeat("長い文字列😀 long string ", longStringBytes/32+1))
+}
+
+// checkString round-trips a user named value and returns the outcome, adding a description of
+// changes and errors to result.Details
+func checkString(ser, encoder serializers.Serializer, result *serializers.SymmetryResult, name, value string) string {
+	original := models.User{ID: 9, Name: value, Email: "test9@example.com"}
+
+	data, err := ser.Marshal(original)
+	if err != nil {
+		result.Details += fmt.Sprintf("String %s marshal error: %v; ", name, err)
+		return serializers.StringError
+	}
+	restored, err := ser.Unmarshal(data)
+	if err != nil {
+		result.Details += fmt.Sprintf("String %s unmarshal error: %v; ", name, err)
+		return serializers.StringError
+	}
+
+	switch got := restored.Name; {
+	case got == value:
+		if encoded, err := encoder.Marshal(original); err == nil && !bytes.Contains(encoded, []byte(value)) {
+			return serializers.StringEscaped
+		}
+		return serializers.StringPreserved
+	case utf8.ValidString(got) && strings.Count(got, "\uFFFD") > strings.Count(value, "\uFFFD"):
+		result.Details += fmt.Sprintf("String %s replaced: original=%s, restored=%s; ", name, quote(value), quote(got))
+		return serializers.StringReplaced
+	default:
+		result.Details += fmt.Sprintf("String %s lost: original=%s, restored=%s; ", name, quote(value), quote(got))
+		return serializers.StringLost
+	}
+}
+
+// quote quotes s for the details, abbreviating long strings
+func quote(s string) string {
+	const maxQuoted = 64
+	if len(s) > maxQuoted {
+		return fmt.Sprintf("%+q... (%d bytes)", s[:maxQuoted], len(s))
+	}
+	return fmt.Sprintf("%+q", s)
+}
@@ -0,0 +1,110 @@
+package benchmark
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/models"
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/serializers"
+)
+
+// Indexes of the time probes returned by timeProbes
+const (
+	timeNanoseconds = iota
+	timeTokyo
+	timeZero
+	timePre1970
+	timeFarFuture
+	timeMonotonic
+)
+
+// timeProbe is a CreatedAt value checked by the time symmetry tests
+type timeProbe struct {
+	name  string
+	value time.Time
+}
+
+// timeProbes returns the CreatedAt values checked by the time symmetry tests in index order
+func timeProbes() []timeProbe {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		// No time zone database available
+		tokyo = time.FixedZone("JST", 9*60*60)
+	}
+
+	return []timeProbe{
+		timeNanoseconds: {"nanoseconds", time.Date(2024, 3, 15, 12, 34, 56, 123456789, time.UTC)},
+		timeTokyo:       {"Asia/Tokyo", time.Date(2024, 3, 15, 21, 34, 56, 0, tokyo)},
+		timeZero:        {"zero", time.Time{}},
+		timePre1970:     {"pre-1970", time.Date(1969, 7, 20, 20, 17, 40, 0, time.UTC)},
+		timeFarFuture:   {"far future", time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)},
+		timeMonotonic:   {"monotonic", time.Now()}, // carries a monotonic clock reading, in time.Local
+	}
+}
+
+// testTimeSymmetry round-trips every time probe as CreatedAt and records which time.Time
+// properties survive in result. The monotonic clock reading never survives serialization, so
+// == compares against the original with the reading stripped. The Asia/Tokyo probe is left out
+// of ==, since no decoder can return the original *time.Location pointer; TimeLocationOK
+// covers it instead. time.Now() is in time.Local, which decoders restore as UTC or a fixed zone,
+// and In or UTC would strip its monotonic reading, so the monotonic probe is compared after
+// moving the restored value into time.Local. Its location is not reported either, so that it
+// only checks what serialization does with a value carrying a monotonic reading.
+func testTimeSymmetry(ser serializers.Serializer, result *serializers.SymmetryResult) {
+	probes := timeProbes()
+	restored := make([]time.Time, len(probes))
+	decoded := make([]bool, len(probes))
+
+	for i, probe := range probes {
+		original := models.User{ID: 5, Name: "Test5", Email: "test5@example.com", CreatedAt: probe.value}
+
+		data, err := ser.Marshal(original)
+		if err != nil {
+			result.Details += fmt.Sprintf("Time %s marshal error: %v; ", probe.name, err)
+			continue
+		}
+		user, err := ser.Unmarshal(data)
+		if err != nil {
+			result.Details += fmt.Sprintf("Time %s unmarshal error: %v; ", probe.name, err)
+			continue
+		}
+		restored[i], decoded[i] = user.CreatedAt, true
+
+		if !user.CreatedAt.Equal(probe.value) || (i != timeMonotonic && !sameLocation(user.CreatedAt, probe.value)) {
+			result.Details += fmt.Sprintf("Time %s: original=%s, restored=%s; ",
+				probe.name, describeTime(probe.value), describeTime(user.CreatedAt))
+		}
+	}
+
+	// preserved reports whether probe i was restored to the same instant
+	preserved := func(i int) bool {
+		return decoded[i] && restored[i].Equal(probes[i].value)
+	}
+
+	result.TimeNanosecondsOK = preserved(timeNanoseconds)
+	result.TimeLocationOK = preserved(timeTokyo) && sameLocation(restored[timeTokyo], probes[timeTokyo].value)
+	result.TimeZeroOK = decoded[timeZero] && restored[timeZero].IsZero()
+	result.TimeRangeOK = preserved(timePre1970) && preserved(timeFarFuture)
+
+	result.TimeEqualOK, result.TimeIdenticalOK = true, true
+	for i, probe := range probes {
+		result.TimeEqualOK = result.TimeEqualOK && preserved(i)
+		got := restored[i]
+		if i == timeMonotonic {
+			got = got.In(probe.value.Location())
+		}
+		if i != timeTokyo {
+			result.TimeIdenticalOK = result.TimeIdenticalOK && decoded[i] && got == probe.value.Round(0)
+		}
+	}
+}
+
+// sameLocation reports whether a and b are in locations with the same name
+func sameLocation(a, b time.Time) bool {
+	return a.Location().String() == b.Location().String()
+}
+
+// describeTime formats t with nanoseconds and its location name
+func describeTime(t time.Time) string {
+	return fmt.Sprintf("%s (%q)", t.Format(time.RFC3339Nano), t.Location().String())
+}
@@ -0,0 +1,185 @@
+package compare
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/serializers"
+)
+
+// Delta describes the change of a serializer's headline metrics between two runs
+type Delta struct {
+	SerializerName string
+	Base           serializers.SerializationResult
+	Current        serializers.SerializationResult
+
+	// Relative changes (0.05 means 5% larger than the baseline)
+	SizeChange      float64
+	MarshalChange   float64
+	UnmarshalChange float64
+
+	Regressions []string // metrics that grew beyond the threshold
+}
+
+// Comparison contains the result of comparing a run against a baseline
+type Comparison struct {
+	Threshold   float64 // relative threshold used to flag regressions
+	Deltas      []Delta
+	OnlyBase    []string // serializers missing from the current run
+	OnlyCurrent []string // serializers missing from the baseline run
+}
+
+// HasRegression reports whether any serializer regressed beyond the threshold
+func (c Comparison) HasRegression() bool {
+	for _, d := range c.Deltas {
+		if len(d.Regressions) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Compare computes per-serializer deltas of size, marshal median and unmarshal median
+// and flags every metric whose relative increase exceeds threshold
+func Compare(base, current []serializers.SerializationResult, threshold float64) Comparison {
+	comparison := Comparison{Threshold: threshold}
+
+	currentByName := make(map[string]serializers.SerializationResult, len(current))
+	for _, result := range current {
+		currentByName[result.SerializerName] = result
+	}
+	baseNames := make(map[string]bool, len(base))
+
+	for _, b := range base {
+		baseNames[b.SerializerName] = true
+		c, ok := currentByName[b.SerializerName]
+		if !ok {
+			comparison.OnlyBase = append(comparison.OnlyBase, b.SerializerName)
+			continue
+		}
+
+		delta := Delta{
+			SerializerName:  b.SerializerName,
+			Base:            b,
+			Current:         c,
+			SizeChange:      relativeChange(int64(b.DataSize), int64(c.DataSize)),
+			MarshalChange:   relativeChange(b.MarshalMedianNs, c.MarshalMedianNs),
+			UnmarshalChange: relativeChange(b.UnmarshalMedianNs, c.UnmarshalMedianNs),
+		}
+		if delta.SizeChange > threshold {
+			delta.Regressions = append(delta.Regressions, "size")
+		}
+		if delta.MarshalChange > threshold {
+			delta.Regressions = append(delta.Regressions, "marshal")
+		}
+		if delta.UnmarshalChange > threshold {
+			delta.Regressions = append(delta.Regressions, "unmarshal")
+		}
+		comparison.Deltas = append(comparison.Deltas, delta)
+	}
+
+	for _, c := range current {
+		if !baseNames[c.SerializerName] {
+			comparison.OnlyCurrent = append(comparison.OnlyCurrent, c.SerializerName)
+		}
+	}
+
+	return comparison
+}
+
+// relativeChange returns (current - base) / base, or 0 when base is zero
+func relativeChange(base, current int64) float64 {
+	if base == 0 {
+		return 0
+	}
+	return float64(current-base) / float64(base)
+}
+
+// LoadResults loads serialization results from a saved CSV file or JSON report
+func LoadResults(path string) ([]serializers.SerializationResult, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return loadJSON(path)
+	case ".csv":
+		return loadCSV(path)
+	default:
+		return nil, fmt.Errorf("unsupported result file %q: expected .csv or .json", path)
+	}
+}
+
+// loadJSON loads the serialization results of a JSON report
+func loadJSON(path string) ([]serializers.SerializationResult, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	var report struct {
+		Serialization []serializers.SerializationResult
+	}
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON report %s: %w", path, err)
+	}
+	if len(report.Serialization) == 0 {
+		return nil, fmt.Errorf("no serialization results in %s", path)
+	}
+	return report.Serialization, nil
+}
+
+// loadCSV loads serialization results from a serialization_results CSV file
+func loadCSV(path string) ([]serializers.SerializationResult, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CSV %s: %w", path, err)
+	}
+	if len(records) < 2 {
+		return nil, fmt.Errorf("no serialization results in %s", path)
+	}
+
+	// Locate columns by header name so that added columns do not break loading
+	columns := make(map[string]int)
+	for i, name := range records[0] {
+		columns[name] = i
+	}
+	for _, name := range []string{"Serializer", "DataSize_Bytes", "MarshalMedian_ns", "UnmarshalMedian_ns"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("missing column %q in %s", name, path)
+		}
+	}
+
+	results := make([]serializers.SerializationResult, 0, len(records)-1)
+	for line, record := range records[1:] {
+		dataSize, err := strconv.Atoi(record[columns["DataSize_Bytes"]])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid DataSize_Bytes: %w", line+2, err)
+		}
+		marshalMedian, err := strconv.ParseInt(record[columns["MarshalMedian_ns"]], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid MarshalMedian_ns: %w", line+2, err)
+		}
+		unmarshalMedian, err := strconv.ParseInt(record[columns["UnmarshalMedian_ns"]], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid UnmarshalMedian_ns: %w", line+2, err)
+		}
+
+		results = append(results, serializers.SerializationResult{
+			SerializerName:    record[columns["Serializer"]],
+			DataSize:          dataSize,
+			MarshalMedianNs:   marshalMedian,
+			UnmarshalMedianNs: unmarshalMedian,
+		})
+	}
+
+	return results, nil
+}
@@ -0,0 +1,147 @@
+package compare
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/serializers"
+)
+
+// result returns a serialization result with the metrics Compare reads
+func result(name string, size int, marshalNs, unmarshalNs int64) serializers.SerializationResult {
+	return serializers.SerializationResult{
+		SerializerName:    name,
+		DataSize:          size,
+		MarshalMedianNs:   marshalNs,
+		UnmarshalMedianNs: unmarshalNs,
+	}
+}
+
+func TestCompare(t *testing.T) {
+	base := result("JSON", 1000, 200, 400)
+	tests := []struct {
+		name        string
+		current     serializers.SerializationResult
+		size        float64
+		marshal     float64
+		unmarshal   float64
+		regressions []string
+	}{
+		{"unchanged", base, 0, 0, 0, nil},
+		{"improved", result("JSON", 900, 100, 300), -0.1, -0.5, -0.25, nil},
+		{"at threshold", result("JSON", 1040, 208, 420), 0.04, 0.04, 0.05, nil},
+		{"regressed", result("JSON", 1200, 300, 800), 0.2, 0.5, 1, []string{"size", "marshal", "unmarshal"}},
+		{"regressed unmarshal only", result("JSON", 1000, 150, 440), 0, -0.25, 0.1, []string{"unmarshal"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comparison := Compare([]serializers.SerializationResult{base}, []serializers.SerializationResult{tt.current}, 0.05)
+			if len(comparison.Deltas) != 1 {
+				t.Fatalf("got %d deltas, want 1", len(comparison.Deltas))
+			}
+			d := comparison.Deltas[0]
+			for _, change := range []struct {
+				metric    string
+				got, want float64
+			}{
+				{"size", d.SizeChange, tt.size},
+				{"marshal", d.MarshalChange, tt.marshal},
+				{"unmarshal", d.UnmarshalChange, tt.unmarshal},
+			} {
+				if math.Abs(change.got-change.want) > 1e-12 {
+					t.Errorf("%s change = %g, want %g", change.metric, change.got, change.want)
+				}
+			}
+			if !slices.Equal(d.Regressions, tt.regressions) {
+				t.Errorf("Regressions = %v, want %v", d.Regressions, tt.regressions)
+			}
+			if got, want := comparison.HasRegression(), len(tt.regressions) > 0; got != want {
+				t.Errorf("HasRegression() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestCompareUnmatchedSerializers(t *testing.T) {
+	base := []serializers.SerializationResult{result("JSON", 100, 10, 10), result("Gob", 100, 10, 10)}
+	current := []serializers.SerializationResult{result("JSON", 100, 10, 10), result("Msgp", 100, 10, 10)}
+
+	comparison := Compare(base, current, 0.05)
+	if len(comparison.Deltas) != 1 || comparison.Deltas[0].SerializerName != "JSON" {
+		t.Errorf("Deltas = %+v, want a single JSON delta", comparison.Deltas)
+	}
+	if !slices.Equal(comparison.OnlyBase, []string{"Gob"}) {
+		t.Errorf("OnlyBase = %v, want [Gob]", comparison.OnlyBase)
+	}
+	if !slices.Equal(comparison.OnlyCurrent, []string{"Msgp"}) {
+		t.Errorf("OnlyCurrent = %v, want [Msgp]", comparison.OnlyCurrent)
+	}
+	if comparison.HasRegression() {
+		t.Errorf("HasRegression() = true for unchanged results")
+	}
+}
+
+func TestCompareZeroBaseline(t *testing.T) {
+	comparison := Compare([]serializers.SerializationResult{result("JSON", 0, 0, 0)},
+		[]serializers.SerializationResult{result("JSON", 100, 10, 10)}, 0.05)
+	if d := comparison.Deltas[0]; d.SizeChange != 0 || d.MarshalChange != 0 || d.UnmarshalChange != 0 || d.Regressions != nil {
+		t.Errorf("delta against a zero baseline = %+v, want no change", d)
+	}
+}
+
+func TestLoadResults(t *testing.T) {
+	dir := t.TempDir()
+	want := []serializers.SerializationResult{result("JSON", 1234, 200, 400), result("Msgp", 567, 50, 90)}
+
+	files := map[string]string{
+		// Columns are located by name, so reordered and unknown columns are fine
+		"results.csv": "Serializer,Extra,UnmarshalMedian_ns,DataSize_Bytes,MarshalMedian_ns\n" +
+			"JSON,x,400,1234,200\n" +
+			"Msgp,y,90,567,50\n",
+		"report.json": `{"Serialization": [
+			{"SerializerName": "JSON", "DataSize": 1234, "MarshalMedianNs": 200, "UnmarshalMedianNs": 400},
+			{"SerializerName": "Msgp", "DataSize": 567, "MarshalMedianNs": 50, "UnmarshalMedianNs": 90}]}`,
+	}
+	for name, content := range files {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(dir, name)
+			if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			got, err := LoadResults(path)
+			if err != nil {
+				t.Fatalf("LoadResults failed: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("LoadResults = %+v, want %+v", got, want)
+			}
+		})
+	}
+
+	invalid := map[string]string{
+		"missing-column.csv": "Serializer,DataSize_Bytes\nJSON,1\n",
+		"bad-number.csv":     "Serializer,DataSize_Bytes,MarshalMedian_ns,UnmarshalMedian_ns\nJSON,big,1,1\n",
+		"header-only.csv":    "Serializer,DataSize_Bytes,MarshalMedian_ns,UnmarshalMedian_ns\n",
+		"empty.json":         `{"Serialization": []}`,
+		"truncated.json":     "{",
+		"results.txt":        "",
+	}
+	for name, content := range invalid {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(dir, name)
+			if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := LoadResults(path); err == nil {
+				t.Errorf("LoadResults(%s) succeeded, want an error", name)
+			}
+		})
+	}
+	if _, err := LoadResults(filepath.Join(dir, "missing.json")); err == nil {
+		t.Errorf("LoadResults of a missing file succeeded, want an error")
+	}
+}
@@ -0,0 +1,278 @@
+// Package fidelity compares values with their round-tripped counterparts field by field
+package fidelity
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Aspect is a property of a value that a round trip may fail to preserve
+type Aspect string
+
+const (
+	// AspectValue means the value changed
+	AspectValue Aspect = "value"
+	// AspectType means the dynamic type of an interface value changed, e.g. int became int64
+	AspectType Aspect = "type"
+	// AspectNil means a nil value became non-nil or vice versa, e.g. a nil slice became empty
+	AspectNil Aspect = "nil"
+	// AspectLocation means a time.Time kept its instant but not its location
+	AspectLocation Aspect = "location"
+)
+
+// Aspects lists every aspect in report order
+var Aspects = []Aspect{AspectValue, AspectType, AspectNil, AspectLocation}
+
+// exampleLength is the maximum length of a formatted value in Field.Example
+const exampleLength = 60
+
+// timeType is the reflect.Type of time.Time, which is compared by instant and location
+var timeType = reflect.TypeOf(time.Time{})
+
+// Field contains the round-trip fidelity of one field path over all compared values. Paths
+// join struct field names and map keys with "." and mark slice elements with "[]", e.g.
+// "Profile.SocialLinks[].URL" or "Metadata.meta3".
+type Field struct {
+	Path     string
+	Compared int            // number of values compared at this path
+	Lost     map[Aspect]int // number of values that did not preserve each aspect
+	Example  string         // first difference found at this path
+}
+
+// OK reports whether every compared value at this path survived the round trip
+func (f Field) OK() bool {
+	return f.LostCount() == 0
+}
+
+// LostCount returns the number of compared values that did not survive the round trip
+func (f Field) LostCount() int {
+	n := 0
+	for _, count := range f.Lost {
+		n += count
+	}
+	return n
+}
+
+// Checker compares original values with their round-tripped counterparts and accumulates
+// the differences per field path
+type Checker struct {
+	fields map[string]*Field
+	paths  []string // field paths in struct order, map keys sorted
+}
+
+// NewChecker creates a new Checker
+func NewChecker() *Checker {
+	return &Checker{fields: make(map[string]*Field)}
+}
+
+// Compare compares original with restored, which must have the same static type
+func (c *Checker) Compare(original, restored any) {
+	c.walk("", "", reflect.ValueOf(original), reflect.ValueOf(restored))
+}
+
+// Fields returns the accumulated results of every field path compared so far
+func (c *Checker) Fields() []Field {
+	fields := make([]Field, len(c.paths))
+	for i, path := range c.paths {
+		fields[i] = *c.fields[path]
+	}
+	return fields
+}
+
+// walk compares a and b at path. parent is the path of the enclosing container.
+func (c *Checker) walk(path, parent string, a, b reflect.Value) {
+	// Interface values are compared by their dynamic values at the same path
+	if a.Kind() == reflect.Interface {
+		a, b = a.Elem(), b.Elem()
+		field := c.field(path, parent)
+		switch {
+		case !a.IsValid() && !b.IsValid():
+			field.Compared++
+			return
+		case !a.IsValid() || !b.IsValid():
+			field.Compared++
+			field.lose(AspectNil, a, b)
+			return
+		case a.Type() != b.Type():
+			field.Compared++
+			field.lose(AspectType, a, b)
+			return
+		}
+	}
+
+	if a.Type() == timeType {
+		c.compareTime(c.field(path, parent), a.Interface().(time.Time), b.Interface().(time.Time))
+		return
+	}
+
+	switch a.Kind() {
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if !a.Type().Field(i).IsExported() {
+				continue
+			}
+			c.walk(join(path, a.Type().Field(i).Name), path, a.Field(i), b.Field(i))
+		}
+
+	case reflect.Pointer:
+		field := c.field(path, parent)
+		if a.IsNil() || b.IsNil() {
+			field.Compared++
+			if a.IsNil() != b.IsNil() {
+				field.lose(AspectNil, a, b)
+			}
+			return
+		}
+		c.walk(path, parent, a.Elem(), b.Elem())
+
+	case reflect.Slice, reflect.Array:
+		field := c.field(path, parent)
+		field.Compared++
+		if !c.sameNil(field, a, b) {
+			return
+		}
+		if a.Len() != b.Len() {
+			field.lose(AspectValue, a, b)
+			return
+		}
+		for i := 0; i < a.Len(); i++ {
+			c.walk(path+"[]", path, a.Index(i), b.Index(i))
+		}
+
+	case reflect.Map:
+		field := c.field(path, parent)
+		field.Compared++
+		if !c.sameNil(field, a, b) {
+			return
+		}
+		if a.Len() != b.Len() {
+			field.lose(AspectValue, a, b)
+		}
+		keys := a.MapKeys()
+		slices.SortFunc(keys, func(x, y reflect.Value) int {
+			return strings.Compare(fmt.Sprint(x.Interface()), fmt.Sprint(y.Interface()))
+		})
+		for _, key := range keys {
+			keyPath := join(path, fmt.Sprint(key.Interface()))
+			restored := b.MapIndex(key)
+			if !restored.IsValid() {
+				missing := c.field(keyPath, path)
+				missing.Compared++
+				missing.lose(AspectValue, a.MapIndex(key), restored)
+				continue
+			}
+			c.walk(keyPath, path, a.MapIndex(key), restored)
+		}
+
+	default:
+		field := c.field(path, parent)
+		field.Compared++
+		if !a.Equal(b) {
+			field.lose(AspectValue, a, b)
+		}
+	}
+}
+
+// compareTime compares two times by instant and location
+func (c *Checker) compareTime(field *Field, a, b time.Time) {
+	field.Compared++
+	aZone, aOffset := a.Zone()
+	bZone, bOffset := b.Zone()
+	switch {
+	case !a.Equal(b):
+		field.lose(AspectValue, reflect.ValueOf(a), reflect.ValueOf(b))
+	case a.Location().String() != b.Location().String() || aZone != bZone || aOffset != bOffset:
+		field.loseExample(AspectLocation, fmt.Sprintf("%s (%s) -> %s (%s)", a.Location(), aZone, b.Location(), bZone))
+	}
+}
+
+// sameNil records a nil-ness difference of two slices or maps and reports whether they agree
+func (c *Checker) sameNil(field *Field, a, b reflect.Value) bool {
+	if a.Kind() == reflect.Array || a.IsNil() == b.IsNil() {
+		return true
+	}
+	if a.Len() == 0 && b.Len() == 0 {
+		field.lose(AspectNil, a, b)
+	} else {
+		field.lose(AspectValue, a, b)
+	}
+	return false
+}
+
+// field returns the results of path, creating them after the last known path of parent
+func (c *Checker) field(path, parent string) *Field {
+	if f, ok := c.fields[path]; ok {
+		return f
+	}
+
+	f := &Field{Path: path, Lost: make(map[Aspect]int)}
+	c.fields[path] = f
+
+	at := len(c.paths)
+	if parent != "" {
+		for i := len(c.paths) - 1; i >= 0; i-- {
+			if within(c.paths[i], parent) {
+				at = i + 1
+				break
+			}
+		}
+	}
+	c.paths = slices.Insert(c.paths, at, path)
+	return f
+}
+
+// lose records that a value at the field did not preserve aspect
+func (f *Field) lose(aspect Aspect, original, restored reflect.Value) {
+	if aspect == AspectType {
+		f.loseExample(aspect, fmt.Sprintf("%s -> %s (%s -> %s)",
+			original.Type(), restored.Type(), describe(original), describe(restored)))
+		return
+	}
+	f.loseExample(aspect, describe(original)+" -> "+describe(restored))
+}
+
+// loseExample records that a value at the field did not preserve aspect, with a description
+// of the difference
+func (f *Field) loseExample(aspect Aspect, example string) {
+	f.Lost[aspect]++
+	if f.Example == "" {
+		f.Example = fmt.Sprintf("%s: %s", aspect, example)
+	}
+}
+
+// describe formats a value and its type for Field.Example
+func describe(v reflect.Value) string {
+	if !v.IsValid() {
+		return "<missing>"
+	}
+	if v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return "nil"
+		}
+		v = v.Elem()
+	}
+	if v.Type() == timeType {
+		return v.Interface().(time.Time).String()
+	}
+	s := fmt.Sprintf("%#v", v.Interface())
+	if len(s) > exampleLength {
+		s = s[:exampleLength] + "..."
+	}
+	return s
+}
+
+// join appends name to path
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// within reports whether path is parent or one of its descendants
+func within(path, parent string) bool {
+	return path == parent || strings.HasPrefix(path, parent+".") || strings.HasPrefix(path, parent+"[")
+}
@@ -0,0 +1,113 @@
+package fidelity
+
+import (
+	"maps"
+	"testing"
+	"time"
+)
+
+// record is a value with every kind of field path the Checker distinguishes
+type record struct {
+	ID    int
+	Tags  []string
+	Meta  map[string]any
+	At    time.Time
+	Links []link
+}
+
+// link is a struct nested in a slice of record
+type link struct {
+	URL    string
+	Weight float64
+}
+
+func TestChecker(t *testing.T) {
+	at := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	pairs := []struct {
+		original, restored record
+	}{
+		// Identical
+		{
+			record{ID: 1, Tags: []string{"a"}, Meta: map[string]any{"k": 1, "s": "x"}, At: at, Links: []link{{"u", 1}}},
+			record{ID: 1, Tags: []string{"a"}, Meta: map[string]any{"k": 1, "s": "x"}, At: at, Links: []link{{"u", 1}}},
+		},
+		// A nil slice became empty
+		{
+			record{ID: 2, At: at},
+			record{ID: 2, Tags: []string{}, At: at},
+		},
+		// Changed map entries, a time in another location and a changed slice element
+		{
+			record{ID: 3, Tags: []string{"a", "b"}, Meta: map[string]any{"k": 1, "s": "x", "n": nil}, At: at, Links: []link{{"u", 1}}},
+			record{ID: 3, Tags: []string{"a", "b"}, Meta: map[string]any{"k": int64(1), "s": "y", "n": nil}, At: at.In(time.FixedZone("JST", 9*60*60)), Links: []link{{"u", 2}}},
+		},
+		// A missing map entry
+		{
+			record{Meta: map[string]any{"gone": true}},
+			record{Meta: map[string]any{}},
+		},
+	}
+	want := []Field{
+		{Path: "ID", Compared: 4},
+		{Path: "Tags", Compared: 4, Lost: map[Aspect]int{AspectNil: 1}, Example: "nil: []string(nil) -> []string{}"},
+		{Path: "Tags[]", Compared: 3},
+		{Path: "Meta", Compared: 4, Lost: map[Aspect]int{AspectValue: 1}, Example: `value: map[string]interface {}{"gone":true} -> map[string]interface {}{}`},
+		{Path: "Meta.k", Compared: 2, Lost: map[Aspect]int{AspectType: 1}, Example: "type: int -> int64 (1 -> 1)"},
+		{Path: "Meta.s", Compared: 2, Lost: map[Aspect]int{AspectValue: 1}, Example: `value: "x" -> "y"`},
+		// Paths first seen in a later comparison follow the known paths of their parent
+		{Path: "Meta.n", Compared: 1},
+		{Path: "Meta.gone", Compared: 1, Lost: map[Aspect]int{AspectValue: 1}, Example: "value: true -> <missing>"},
+		{Path: "At", Compared: 4, Lost: map[Aspect]int{AspectLocation: 1}, Example: "location: UTC (UTC) -> JST (JST)"},
+		{Path: "Links", Compared: 4},
+		{Path: "Links[].URL", Compared: 2},
+		{Path: "Links[].Weight", Compared: 2, Lost: map[Aspect]int{AspectValue: 1}, Example: "value: 1 -> 2"},
+	}
+
+	c := NewChecker()
+	for _, pair := range pairs {
+		c.Compare(pair.original, pair.restored)
+	}
+	got := c.Fields()
+	if len(got) != len(want) {
+		var paths []string
+		for _, f := range got {
+			paths = append(paths, f.Path)
+		}
+		t.Fatalf("Fields() has paths %q, want %d paths", paths, len(want))
+	}
+	for i, w := range want {
+		g := got[i]
+		if g.Path != w.Path || g.Compared != w.Compared || !maps.Equal(g.Lost, w.Lost) || g.Example != w.Example {
+			t.Errorf("field %d = %+v, want %+v", i, g, w)
+		}
+		if g.OK() != (len(w.Lost) == 0) || g.LostCount() != len(w.Lost) {
+			t.Errorf("%s: OK() = %t, LostCount() = %d with Lost %v", g.Path, g.OK(), g.LostCount(), g.Lost)
+		}
+	}
+}
+
+func TestCheckerPointers(t *testing.T) {
+	one, two := 1, 2
+	type holder struct{ P *int }
+	tests := []struct {
+		name               string
+		original, restored holder
+		lost               map[Aspect]int
+	}{
+		{"both nil", holder{}, holder{}, nil},
+		{"equal", holder{&one}, holder{&one}, nil},
+		{"changed", holder{&one}, holder{&two}, map[Aspect]int{AspectValue: 1}},
+		{"became nil", holder{&one}, holder{}, map[Aspect]int{AspectNil: 1}},
+		{"became non-nil", holder{}, holder{&one}, map[Aspect]int{AspectNil: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewChecker()
+			c.Compare(tt.original, tt.restored)
+			fields := c.Fields()
+			if len(fields) != 1 || fields[0].Path != "P" || fields[0].Compared != 1 || !maps.Equal(fields[0].Lost, tt.lost) {
+				t.Errorf("Fields() = %+v, want P compared once with Lost %v", fields, tt.lost)
+			}
+		})
+	}
+}
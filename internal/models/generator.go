@@ -0,0 +1,256 @@
+package models
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// ReferenceTime is the fixed point in time that generated CreatedAt values are relative to,
+// so that the same seed always yields identical data
+var ReferenceTime = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+var (
+	platforms = []string{"Twitter", "GitHub", "LinkedIn", "Instagram", "Facebook"}
+	themes    = []string{"dark", "light", "auto", "contrast"}
+	languages = []string{"en", "ja", "fr", "de", "es", "zh", "ko"}
+	timezones = []string{"UTC", "JST", "PST", "EST", "CET", "IST", "CST"}
+	features  = []string{"premium", "beta", "notifications", "analytics", "export", "api", "integration"}
+)
+
+// defaultNotificationKeys are the notification keys and "enabled" thresholds of the
+// original data shape; profiles with more keys add "notifyN" keys
+var defaultNotificationKeys = []struct {
+	key       string
+	threshold float32
+}{
+	{"email", 0.5},
+	{"push", 0.5},
+	{"sms", 0.3},
+	{"desktop", 0.4},
+	{"weekly", 0.6},
+}
+
+// defaultLimitKeys are the limit keys and value ranges of the original data shape;
+// profiles with more keys add "limitN" keys
+var defaultLimitKeys = []struct {
+	key  string
+	base int
+	span int
+}{
+	{"api_calls", 100, 1000},
+	{"storage_mb", 100, 1000},
+	{"connections", 10, 50},
+	{"bandwidth_mb", 50, 500},
+}
+
+// Generator produces deterministic test users from a dedicated random source
+type Generator struct {
+	rng     *rand.Rand
+	profile DataProfile
+	next    int // number of users generated so far
+}
+
+// NewGenerator creates a new Generator seeded with seed that shapes users by profile
+func NewGenerator(seed int64, profile DataProfile) *Generator {
+	return &Generator{
+		rng:     rand.New(rand.NewSource(seed)),
+		profile: profile,
+	}
+}
+
+// GenerateTestUsers generates a specified number of test users from the given seed and profile
+func GenerateTestUsers(count int, seed int64, profile DataProfile) Users {
+	gen := NewGenerator(seed, profile)
+	users := make(Users, count)
+	for i := range users {
+		users[i] = gen.Next()
+	}
+	return users
+}
+
+// Next generates the next test user
+func (g *Generator) Next() User {
+	g.next++
+	n := g.next // 1-based user number
+	rng := g.rng
+	profile := g.profile
+
+	user := User{
+		ID:        int64(n),
+		Name:      fmt.Sprintf("User%d", n),
+		Email:     fmt.Sprintf("user%d@example.com", n),
+		Age:       rng.Intn(60) + 18,
+		IsActive:  rng.Float32() > 0.2, // 80% active
+		CreatedAt: ReferenceTime.Add(-time.Duration(rng.Intn(365*24)) * time.Hour),
+	}
+
+	// Generate profile
+	user.Profile = Profile{
+		FirstName: fmt.Sprintf("First%d", n),
+		LastName:  fmt.Sprintf("Last%d", n),
+		Bio:       fmt.Sprintf("This is a bio for user %d with some additional details", n),
+		Avatar:    fmt.Sprintf("https://example.com/avatars/user%d.jpg", n),
+	}
+	if profile.BioLength.Max > 0 {
+		user.Profile.Bio = g.randomText(g.intn(profile.BioLength))
+	}
+
+	// Generate social links
+	linkCount := g.intn(profile.SocialLinks)
+	user.Profile.SocialLinks = make([]Link, linkCount)
+	for j := 0; j < linkCount; j++ {
+		platform := platforms[rng.Intn(len(platforms))]
+		user.Profile.SocialLinks[j] = Link{
+			Platform: platform,
+			URL:      fmt.Sprintf("https://%s.com/user%d", platform, n),
+		}
+	}
+
+	// Generate preferences
+	user.Profile.Preferences = Preferences{
+		Theme:         themes[rng.Intn(len(themes))],
+		Language:      languages[rng.Intn(len(languages))],
+		Notifications: g.generateNotifications(),
+		Privacy: PrivacySettings{
+			ProfilePublic: rng.Float32() > 0.3,
+			EmailVisible:  rng.Float32() > 0.7,
+			ShowActivity:  rng.Float32() > 0.4,
+		},
+	}
+
+	// Generate settings
+	user.Settings = Settings{
+		Language: languages[rng.Intn(len(languages))],
+		TimeZone: timezones[rng.Intn(len(timezones))],
+		Features: g.generateRandomFeatures(features, g.intn(profile.Features)),
+		Limits:   g.generateLimits(),
+	}
+
+	// Generate tags
+	tagCount := g.intn(profile.Tags)
+	user.Tags = make([]string, tagCount)
+	for j := 0; j < tagCount; j++ {
+		user.Tags[j] = fmt.Sprintf("tag%d", rng.Intn(30)+1)
+	}
+
+	// Generate metadata
+	metadataCount := g.intn(profile.Metadata)
+	user.Metadata = make(map[string]interface{})
+	for j := 0; j < metadataCount; j++ {
+		key := fmt.Sprintf("meta%d", j+1)
+		user.Metadata[key] = g.metadataValue(j+1, 0)
+	}
+
+	return user
+}
+
+// generateNotifications generates the notification settings of a user
+func (g *Generator) generateNotifications() map[string]bool {
+	notifications := make(map[string]bool, g.profile.NotificationKeys)
+	for j := 0; j < g.profile.NotificationKeys; j++ {
+		key, threshold := fmt.Sprintf("notify%d", j+1), float32(0.5)
+		if j < len(defaultNotificationKeys) {
+			key, threshold = defaultNotificationKeys[j].key, defaultNotificationKeys[j].threshold
+		}
+		notifications[key] = g.rng.Float32() > threshold
+	}
+	return notifications
+}
+
+// generateLimits generates the limit settings of a user
+func (g *Generator) generateLimits() map[string]int {
+	limits := make(map[string]int, g.profile.LimitKeys)
+	for j := 0; j < g.profile.LimitKeys; j++ {
+		key, base, span := fmt.Sprintf("limit%d", j+1), 1, 1000
+		if j < len(defaultLimitKeys) {
+			key, base, span = defaultLimitKeys[j].key, defaultLimitKeys[j].base, defaultLimitKeys[j].span
+		}
+		limits[key] = g.rng.Intn(span) + base
+	}
+	return limits
+}
+
+// metadataValue generates a metadata value of a type drawn from the profile's type mix.
+// Nested maps and slices are only generated while depth is below the profile's maximum depth.
+func (g *Generator) metadataValue(index, depth int) interface{} {
+	types := g.profile.MetadataTypes
+	weights := []int{types.String, types.Int, types.Bool, types.Float}
+	if depth < g.profile.MetadataDepth {
+		weights = append(weights, types.Map, types.Slice)
+	}
+
+	total := 0
+	for _, w := range weights {
+		total += w
+	}
+	pick := g.rng.Intn(total)
+	kind := 0
+	for pick >= weights[kind] {
+		pick -= weights[kind]
+		kind++
+	}
+
+	switch kind {
+	case 0:
+		if g.profile.MetadataStringLength.Max > 0 {
+			return g.randomText(g.intn(g.profile.MetadataStringLength))
+		}
+		return fmt.Sprintf("value%d", index)
+	case 1:
+		return g.rng.Intn(1000)
+	case 2:
+		return g.rng.Float32() > 0.5
+	case 3:
+		return g.rng.Float64() * 100
+	case 4:
+		count := g.rng.Intn(4) + 1
+		nested := make(map[string]interface{}, count)
+		for j := 0; j < count; j++ {
+			nested[fmt.Sprintf("key%d", j+1)] = g.metadataValue(j+1, depth+1)
+		}
+		return nested
+	default:
+		nested := make([]interface{}, g.rng.Intn(4)+1)
+		for j := range nested {
+			nested[j] = g.metadataValue(j+1, depth+1)
+		}
+		return nested
+	}
+}
+
+// intn returns a random integer within r
+func (g *Generator) intn(r IntRange) int {
+	return r.Min + g.rng.Intn(r.Max-r.Min+1)
+}
+
+// textAlphabet contains the characters used for generated text
+const textAlphabet = "abcdefghijklmnopqrstuvwxyz     "
+
+// randomText generates ASCII text of the given length
+func (g *Generator) randomText(length int) string {
+	text := make([]byte, length)
+	for i := range text {
+		text[i] = textAlphabet[g.rng.Intn(len(textAlphabet))]
+	}
+	return string(text)
+}
+
+func (g *Generator) generateRandomFeatures(features []string, count int) []string {
+	if count > len(features) {
+		count = len(features)
+	}
+
+	selected := make([]string, 0, count)
+	used := make(map[int]bool)
+
+	for len(selected) < count {
+		idx := g.rng.Intn(len(features))
+		if !used[idx] {
+			selected = append(selected, features[idx])
+			used[idx] = true
+		}
+	}
+
+	return selected
+}
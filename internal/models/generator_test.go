@@ -0,0 +1,70 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// TestGenerateTestUsersDeterministic checks that the same seed and profile always generate
+// identical users, down to the JSON encoding, and that another seed generates different users
+func TestGenerateTestUsersDeterministic(t *testing.T) {
+	tests := []struct {
+		profile DataProfile
+		count   int
+	}{
+		{ProfileMinimal, 50},
+		{ProfileTypical, 50},
+		{ProfileHeavy, 20},
+		{ProfilePathological, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.profile.Name, func(t *testing.T) {
+			first := GenerateTestUsers(tt.count, 42, tt.profile)
+			second := GenerateTestUsers(tt.count, 42, tt.profile)
+			if !reflect.DeepEqual(first, second) {
+				t.Fatalf("two runs with seed 42 generated different users")
+			}
+
+			if !bytes.Equal(canonicalJSON(t, first), canonicalJSON(t, second)) {
+				t.Errorf("two runs with seed 42 generated different JSON")
+			}
+
+			if other := GenerateTestUsers(tt.count, 43, tt.profile); reflect.DeepEqual(first, other) {
+				t.Errorf("seeds 42 and 43 generated identical users")
+			}
+		})
+	}
+}
+
+// canonicalJSON returns the JSON encoding of users with sorted object keys. The generated
+// easyjson methods write maps in iteration order, so the encoding is decoded and encoded again
+// with encoding/json, which sorts map keys.
+func canonicalJSON(t *testing.T, users Users) []byte {
+	t.Helper()
+	data, err := json.Marshal(users)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var generic any
+	if err := json.Unmarshal(data, &generic); err != nil {
+		t.Fatal(err)
+	}
+	if data, err = json.Marshal(generic); err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// TestGeneratorNext checks that streaming users from a Generator yields the users
+// GenerateTestUsers returns for the same seed
+func TestGeneratorNext(t *testing.T) {
+	want := GenerateTestUsers(10, 7, ProfileHeavy)
+	gen := NewGenerator(7, ProfileHeavy)
+	for i, user := range want {
+		if got := gen.Next(); !reflect.DeepEqual(got, user) {
+			t.Fatalf("user %d from Next differs from GenerateTestUsers", i+1)
+		}
+	}
+}
@@ -0,0 +1,220 @@
+package models
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Built-in data file formats
+const (
+	FormatAuto   = "auto"   // detect from the file extension
+	FormatJSON   = "json"   // JSON array of users
+	FormatNDJSON = "ndjson" // one JSON user per line
+)
+
+// UsersDecoder decodes a serialized collection of users (implemented by every serializer)
+type UsersDecoder interface {
+	Name() string
+	UnmarshalUsers(data []byte) (Users, error)
+}
+
+// RecordError describes a record of a data file that could not be decoded or is invalid
+type RecordError struct {
+	Index int   // 0-based index of the record
+	Line  int   // 1-based line of the record in NDJSON files, 0 otherwise
+	Err   error // decoding or validation error
+}
+
+// Error returns the error message prefixed with the record position
+func (e RecordError) Error() string {
+	return fmt.Sprintf("%s: %v", recordPosition(e.Index, e.Line), e.Err)
+}
+
+// recordPosition describes the position of a record in a data file
+func recordPosition(index, line int) string {
+	if line > 0 {
+		return fmt.Sprintf("line %d", line)
+	}
+	return fmt.Sprintf("record %d", index)
+}
+
+// Unwrap returns the underlying error
+func (e RecordError) Unwrap() error {
+	return e.Err
+}
+
+// LoadUsers loads users from a data file. format is FormatAuto, FormatJSON, FormatNDJSON or
+// the name of one of the given decoders (case-insensitive). Records that fail to decode or
+// validate are skipped and returned as RecordErrors; the returned error is only set when the
+// file as a whole cannot be read.
+func LoadUsers(path, format string, decoders []UsersDecoder) (Users, []RecordError, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read data file: %w", err)
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil, fmt.Errorf("data file %s is empty", path)
+	}
+
+	format = strings.ToLower(format)
+	if format == "" || format == FormatAuto {
+		format = detectFormat(path)
+	}
+
+	var (
+		users     Users
+		recordErr []RecordError
+	)
+	switch format {
+	case FormatJSON:
+		users, recordErr, err = decodeJSONArray(data)
+	case FormatNDJSON:
+		users, recordErr, err = decodeNDJSON(data)
+	default:
+		users, recordErr, err = decodeWith(data, format, decoders)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load %s: %w", path, err)
+	}
+
+	return users, recordErr, nil
+}
+
+// detectFormat returns the data file format implied by the file extension
+func detectFormat(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".ndjson", ".jsonl":
+		return FormatNDJSON
+	default:
+		return FormatJSON
+	}
+}
+
+// decodeJSONArray decodes a JSON array of users element by element
+func decodeJSONArray(data []byte) (Users, []RecordError, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, nil, fmt.Errorf("expected a JSON array of users: %w", err)
+	}
+
+	v := newValidator(len(raw))
+	for i, element := range raw {
+		var user User
+		if err := json.Unmarshal(element, &user); err != nil {
+			v.reject(i, 0, err)
+			continue
+		}
+		v.add(i, 0, user)
+	}
+	return v.users, v.errors, nil
+}
+
+// decodeNDJSON decodes one JSON user per line, ignoring blank lines
+func decodeNDJSON(data []byte) (Users, []RecordError, error) {
+	v := newValidator(bytes.Count(data, []byte("\n")) + 1)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(data)+1)
+	line, index := 0, 0
+	for scanner.Scan() {
+		line++
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		var user User
+		if err := json.Unmarshal(text, &user); err != nil {
+			v.reject(index, line, err)
+		} else {
+			v.add(index, line, user)
+		}
+		index++
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+	return v.users, v.errors, nil
+}
+
+// decodeWith decodes the data with the decoder called name and validates each record
+func decodeWith(data []byte, name string, decoders []UsersDecoder) (Users, []RecordError, error) {
+	var names []string
+	for _, decoder := range decoders {
+		if !strings.EqualFold(decoder.Name(), name) {
+			names = append(names, decoder.Name())
+			continue
+		}
+
+		decoded, err := decoder.UnmarshalUsers(data)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s decoding failed: %w", decoder.Name(), err)
+		}
+		v := newValidator(len(decoded))
+		for i, user := range decoded {
+			v.add(i, 0, user)
+		}
+		return v.users, v.errors, nil
+	}
+
+	return nil, nil, fmt.Errorf("unknown data format %q (expected %s, %s or one of %s)",
+		name, FormatJSON, FormatNDJSON, strings.Join(names, ", "))
+}
+
+// validator collects valid users and the errors of invalid records
+type validator struct {
+	users  Users
+	errors []RecordError
+	seen   map[int64]string // user ID -> position of the first record with that ID
+}
+
+// newValidator creates a new validator for about n records
+func newValidator(n int) *validator {
+	return &validator{
+		users: make(Users, 0, n),
+		seen:  make(map[int64]string, n),
+	}
+}
+
+// add validates a decoded user and keeps it if it is valid
+func (v *validator) add(index, line int, user User) {
+	if err := ValidateUser(user); err != nil {
+		v.reject(index, line, err)
+		return
+	}
+	if first, ok := v.seen[user.ID]; ok {
+		v.reject(index, line, fmt.Errorf("duplicate id %d (first seen in %s)", user.ID, first))
+		return
+	}
+	v.seen[user.ID] = recordPosition(index, line)
+	v.users = append(v.users, user)
+}
+
+// reject records the error of an invalid record
+func (v *validator) reject(index, line int, err error) {
+	v.errors = append(v.errors, RecordError{Index: index, Line: line, Err: err})
+}
+
+// ValidateUser checks the fields a benchmark user must have
+func ValidateUser(user User) error {
+	var problems []string
+	if user.ID <= 0 {
+		problems = append(problems, fmt.Sprintf("id must be positive, got %d", user.ID))
+	}
+	if user.Name == "" {
+		problems = append(problems, "name is empty")
+	}
+	if user.Age < 0 {
+		problems = append(problems, fmt.Sprintf("age must not be negative, got %d", user.Age))
+	}
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, "; "))
+	}
+	return nil
+}
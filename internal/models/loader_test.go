@@ -0,0 +1,202 @@
+package models
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// msgpDecoder decodes users encoded with the generated msgp methods, standing in for a serializer
+type msgpDecoder struct{}
+
+func (msgpDecoder) Name() string { return "Msgp" }
+
+func (msgpDecoder) UnmarshalUsers(data []byte) (Users, error) {
+	var users Users
+	_, err := users.UnmarshalMsg(data)
+	return users, err
+}
+
+// writeDataFile writes content to name in a temporary directory and returns its path
+func writeDataFile(t *testing.T, name string, content []byte) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, content, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestDetectFormat(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"users.json", FormatJSON},
+		{"USERS.JSON", FormatJSON},
+		{"users.ndjson", FormatNDJSON},
+		{"users.NDJSON", FormatNDJSON},
+		{"dir.v2/users.jsonl", FormatNDJSON},
+		{"users.txt", FormatJSON},
+		{"users", FormatJSON},
+	}
+	for _, tt := range tests {
+		if got := detectFormat(tt.path); got != tt.want {
+			t.Errorf("detectFormat(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
+
+// recordPos is the position and error text of an expected RecordError
+type recordPos struct {
+	index, line int
+	err         string // substring of the error
+}
+
+func TestLoadUsers(t *testing.T) {
+	users := GenerateTestUsers(2, 1, ProfileTypical)
+	encoded, err := users.MarshalMsg(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		file    string
+		format  string
+		content string
+		ids     []int64
+		errors  []recordPos
+	}{
+		{
+			name:    "JSON array",
+			file:    "users.json",
+			content: `[{"id": 1, "name": "a"}, {"id": 2, "name": "b", "age": 30}]`,
+			ids:     []int64{1, 2},
+		},
+		{
+			name:   "JSON array with bad records",
+			file:   "users.json",
+			format: FormatAuto,
+			content: `[{"id": 1, "name": "a"}, {"id": "two", "name": "b"}, {"id": 0, "name": ""},
+				{"id": 1, "name": "again"}, {"id": 3, "name": "c", "age": -1}, {"id": 4, "name": "d"}]`,
+			ids: []int64{1, 4},
+			errors: []recordPos{
+				{1, 0, "expected number"},
+				{2, 0, "id must be positive, got 0; name is empty"},
+				{3, 0, "duplicate id 1 (first seen in record 0)"},
+				{4, 0, "age must not be negative"},
+			},
+		},
+		{
+			name:    "NDJSON",
+			file:    "users.jsonl",
+			content: "{\"id\": 1, \"name\": \"a\"}\n{\"id\": 2, \"name\": \"b\"}\n",
+			ids:     []int64{1, 2},
+		},
+		{
+			name: "NDJSON with bad records",
+			file: "users.ndjson",
+			content: "{\"id\": 1, \"name\": \"a\"}\n\n  \n{\"id\": 2, \"name\": \n" +
+				"{\"id\": 1, \"name\": \"b\"}\r\n{\"id\": 3, \"name\": \"c\"}",
+			ids: []int64{1, 3},
+			errors: []recordPos{
+				{1, 4, "unexpected end of JSON input"},
+				{2, 5, "duplicate id 1 (first seen in line 1)"},
+			},
+		},
+		{
+			name:    "format overrides the extension",
+			file:    "users.json",
+			format:  "NDJSON",
+			content: "{\"id\": 5, \"name\": \"e\"}\n",
+			ids:     []int64{5},
+		},
+		{
+			name:    "serializer encoding",
+			file:    "users.msgp",
+			format:  "msgp",
+			content: string(encoded),
+			ids:     []int64{users[0].ID, users[1].ID},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeDataFile(t, tt.file, []byte(tt.content))
+			got, recordErrors, err := LoadUsers(path, tt.format, []UsersDecoder{msgpDecoder{}})
+			if err != nil {
+				t.Fatalf("LoadUsers failed: %v", err)
+			}
+
+			var ids []int64
+			for _, user := range got {
+				ids = append(ids, user.ID)
+			}
+			if !reflect.DeepEqual(ids, tt.ids) {
+				t.Errorf("loaded ids %v, want %v", ids, tt.ids)
+			}
+
+			if len(recordErrors) != len(tt.errors) {
+				t.Fatalf("got %d record errors %v, want %d", len(recordErrors), recordErrors, len(tt.errors))
+			}
+			for i, want := range tt.errors {
+				got := recordErrors[i]
+				if got.Index != want.index || got.Line != want.line || !strings.Contains(got.Error(), want.err) {
+					t.Errorf("record error %d = {Index: %d, Line: %d, %q}, want {Index: %d, Line: %d, containing %q}",
+						i, got.Index, got.Line, got.Error(), want.index, want.line, want.err)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadUsersSerializerRoundTrip(t *testing.T) {
+	users := GenerateTestUsers(5, 1, ProfileHeavy)
+	encoded, err := users.MarshalMsg(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := msgpDecoder{}.UnmarshalUsers(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, recordErrors, err := LoadUsers(writeDataFile(t, "users.bin", encoded), "Msgp", []UsersDecoder{msgpDecoder{}})
+	if err != nil || len(recordErrors) > 0 {
+		t.Fatalf("LoadUsers failed: %v %v", err, recordErrors)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("loaded users differ from the decoded Msgp encoding")
+	}
+}
+
+func TestLoadUsersErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		format  string
+		content string
+		err     string
+	}{
+		{"empty JSON", "users.json", "", "", "is empty"},
+		{"whitespace NDJSON", "users.ndjson", "", " \n\n", "is empty"},
+		{"not an array", "users.json", "", `{"id": 1, "name": "a"}`, "expected a JSON array of users"},
+		{"unknown format", "users.bin", "yaml", "x", `unknown data format "yaml" (expected json, ndjson or one of Msgp)`},
+		{"decoder failure", "users.bin", "Msgp", "\xc1", "Msgp decoding failed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeDataFile(t, tt.file, []byte(tt.content))
+			_, _, err := LoadUsers(path, tt.format, []UsersDecoder{msgpDecoder{}})
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("LoadUsers error = %v, want one containing %q", err, tt.err)
+			}
+		})
+	}
+
+	if _, _, err := LoadUsers(filepath.Join(t.TempDir(), "missing.json"), "", nil); err == nil {
+		t.Errorf("LoadUsers of a missing file succeeded, want an error")
+	}
+}
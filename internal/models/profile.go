@@ -0,0 +1,203 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+)
+
+// IntRange is an inclusive range of integers
+type IntRange struct {
+	Min int `json:"min"`
+	Max int `json:"max"`
+}
+
+// MetadataTypeMix contains the relative weights of generated metadata value types
+type MetadataTypeMix struct {
+	String int `json:"string"`
+	Int    int `json:"int"`
+	Bool   int `json:"bool"`
+	Float  int `json:"float"`
+	Map    int `json:"map"`   // nested map[string]interface{}
+	Slice  int `json:"slice"` // nested []interface{}
+}
+
+// DataProfile controls the shape of generated test data
+type DataProfile struct {
+	Name                 string          `json:"name"`
+	SocialLinks          IntRange        `json:"social_links"`
+	Tags                 IntRange        `json:"tags"`
+	Features             IntRange        `json:"features"`
+	NotificationKeys     int             `json:"notification_keys"`
+	LimitKeys            int             `json:"limit_keys"`
+	Metadata             IntRange        `json:"metadata"`
+	BioLength            IntRange        `json:"bio_length"`             // zero range keeps the default one-sentence bio
+	MetadataStringLength IntRange        `json:"metadata_string_length"` // zero range keeps "valueN" strings
+	MetadataTypes        MetadataTypeMix `json:"metadata_types"`
+	MetadataDepth        int             `json:"metadata_depth"` // maximum nesting depth of map/slice values
+}
+
+// Built-in data profiles
+var (
+	// ProfileMinimal produces tiny users with almost no collection entries
+	ProfileMinimal = DataProfile{
+		Name:             "minimal",
+		Features:         IntRange{Min: 1, Max: 1},
+		NotificationKeys: 1,
+		LimitKeys:        1,
+		BioLength:        IntRange{Min: 8, Max: 16},
+		MetadataTypes:    MetadataTypeMix{String: 1, Int: 1, Bool: 1, Float: 1},
+	}
+
+	// ProfileTypical reproduces the original fixed shape of the generator
+	ProfileTypical = DataProfile{
+		Name:             "typical",
+		SocialLinks:      IntRange{Min: 0, Max: 4},
+		Tags:             IntRange{Min: 0, Max: 6},
+		Features:         IntRange{Min: 1, Max: 4},
+		NotificationKeys: 5,
+		LimitKeys:        4,
+		Metadata:         IntRange{Min: 0, Max: 4},
+		MetadataTypes:    MetadataTypeMix{String: 1, Int: 1, Bool: 1, Float: 1},
+	}
+
+	// ProfileHeavy produces large users with long strings and nested metadata
+	ProfileHeavy = DataProfile{
+		Name:                 "heavy",
+		SocialLinks:          IntRange{Min: 5, Max: 20},
+		Tags:                 IntRange{Min: 10, Max: 50},
+		Features:             IntRange{Min: 3, Max: 7},
+		NotificationKeys:     20,
+		LimitKeys:            16,
+		Metadata:             IntRange{Min: 10, Max: 40},
+		BioLength:            IntRange{Min: 200, Max: 2000},
+		MetadataStringLength: IntRange{Min: 16, Max: 256},
+		MetadataTypes:        MetadataTypeMix{String: 3, Int: 2, Bool: 1, Float: 2, Map: 1, Slice: 1},
+		MetadataDepth:        2,
+	}
+
+	// ProfilePathological produces extreme users (use with a small -count)
+	ProfilePathological = DataProfile{
+		Name:                 "pathological",
+		SocialLinks:          IntRange{Min: 50, Max: 200},
+		Tags:                 IntRange{Min: 200, Max: 1000},
+		Features:             IntRange{Min: 7, Max: 7},
+		NotificationKeys:     100,
+		LimitKeys:            100,
+		Metadata:             IntRange{Min: 100, Max: 300},
+		BioLength:            IntRange{Min: 10000, Max: 50000},
+		MetadataStringLength: IntRange{Min: 1000, Max: 10000},
+		MetadataTypes:        MetadataTypeMix{String: 1, Int: 1, Bool: 1, Float: 1, Map: 2, Slice: 2},
+		MetadataDepth:        5,
+	}
+)
+
+// builtinProfiles lists the built-in profiles in display order
+var builtinProfiles = []DataProfile{ProfileMinimal, ProfileTypical, ProfileHeavy, ProfilePathological}
+
+// ProfileNames returns the names of the built-in profiles
+func ProfileNames() []string {
+	names := make([]string, len(builtinProfiles))
+	for i, p := range builtinProfiles {
+		names[i] = p.Name
+	}
+	return names
+}
+
+// LookupProfile returns the built-in profile with the given name
+func LookupProfile(name string) (DataProfile, bool) {
+	for _, p := range builtinProfiles {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return DataProfile{}, false
+}
+
+// ResolveProfile returns the built-in profile called nameOrPath, or loads it from a
+// JSON profile config file if no built-in profile has that name
+func ResolveProfile(nameOrPath string) (DataProfile, error) {
+	if p, ok := LookupProfile(nameOrPath); ok {
+		return p, nil
+	}
+	if _, err := os.Stat(nameOrPath); err != nil {
+		return DataProfile{}, fmt.Errorf("unknown profile %q (built-in profiles: %v)", nameOrPath, ProfileNames())
+	}
+	return LoadProfile(nameOrPath)
+}
+
+// LoadProfile loads a profile from a JSON config file. The optional "base" field names a
+// built-in profile (default "typical") whose values are used for fields the file omits.
+func LoadProfile(path string) (DataProfile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return DataProfile{}, fmt.Errorf("failed to read profile: %w", err)
+	}
+
+	var header struct {
+		Base string `json:"base"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return DataProfile{}, fmt.Errorf("failed to parse profile %s: %w", path, err)
+	}
+	if header.Base == "" {
+		header.Base = ProfileTypical.Name
+	}
+
+	profile, ok := LookupProfile(header.Base)
+	if !ok {
+		return DataProfile{}, fmt.Errorf("unknown base profile %q in %s", header.Base, path)
+	}
+	profile.Name = path
+	if err := json.Unmarshal(data, &profile); err != nil {
+		return DataProfile{}, fmt.Errorf("failed to parse profile %s: %w", path, err)
+	}
+
+	if err := profile.Validate(); err != nil {
+		return DataProfile{}, fmt.Errorf("invalid profile %s: %w", path, err)
+	}
+	return profile, nil
+}
+
+// Validate checks that all ranges and weights of the profile are usable
+func (p DataProfile) Validate() error {
+	ranges := []struct {
+		name string
+		r    IntRange
+	}{
+		{"social_links", p.SocialLinks},
+		{"tags", p.Tags},
+		{"features", p.Features},
+		{"metadata", p.Metadata},
+		{"bio_length", p.BioLength},
+		{"metadata_string_length", p.MetadataStringLength},
+	}
+	for _, r := range ranges {
+		if r.r.Min < 0 || r.r.Max < r.r.Min {
+			return fmt.Errorf("%s: invalid range [%d, %d]", r.name, r.r.Min, r.r.Max)
+		}
+	}
+
+	if p.NotificationKeys < 0 || p.LimitKeys < 0 || p.MetadataDepth < 0 {
+		return fmt.Errorf("notification_keys, limit_keys and metadata_depth must not be negative")
+	}
+
+	weights := []int{
+		p.MetadataTypes.String, p.MetadataTypes.Int, p.MetadataTypes.Bool,
+		p.MetadataTypes.Float, p.MetadataTypes.Map, p.MetadataTypes.Slice,
+	}
+	if slices.Min(weights) < 0 {
+		return fmt.Errorf("metadata_types: weights must not be negative")
+	}
+	if p.Metadata.Max > 0 && p.scalarMetadataWeight() == 0 {
+		return fmt.Errorf("metadata_types: at least one scalar type needs a positive weight")
+	}
+	return nil
+}
+
+// scalarMetadataWeight returns the total weight of non-nested metadata value types
+func (p DataProfile) scalarMetadataWeight() int {
+	t := p.MetadataTypes
+	return t.String + t.Int + t.Bool + t.Float
+}
@@ -20,6 +20,7 @@ type Client struct {
 // RedisResult contains Redis SET/GET performance results
 type RedisResult struct {
 	SerializerName string
+	DataSize       int // bytes stored per SET
 
 	// Pure I/O times (Redis operations only)
 	SetTimes    []int64 // nanoseconds
@@ -36,6 +37,12 @@ type RedisResult struct {
 	TotalSetMedianNs int64
 	TotalGetAvgNs    int64
 	TotalGetMedianNs int64
+
+	// Allocation and GC activity per iteration (including serialization)
+	TotalSetAllocStats []utils.AllocStats // marshal + SET
+	TotalGetAllocStats []utils.AllocStats // GET + unmarshal
+	TotalSetAllocAvg   utils.AllocStats
+	TotalGetAllocAvg   utils.AllocStats
 }
 
 // NewClient creates a new Redis client
@@ -87,6 +94,9 @@ func (c *Client) benchmarkSerializer(ser serializers.Serializer, users models.Us
 		GetTimes:       make([]int64, iterations),
 		TotalSetTimes:  make([]int64, iterations),
 		TotalGetTimes:  make([]int64, iterations),
+
+		TotalSetAllocStats: make([]utils.AllocStats, iterations),
+		TotalGetAllocStats: make([]utils.AllocStats, iterations),
 	}
 
 	keyPrefix := fmt.Sprintf("benchmark:%s:users", ser.Name())
@@ -96,6 +106,7 @@ func (c *Client) benchmarkSerializer(ser serializers.Serializer, users models.Us
 		key := fmt.Sprintf("%s:%d", keyPrefix, i)
 
 		// Measure total SET operation (marshal + SET)
+		setBefore := utils.TakeMemSnapshot()
 		totalSetStart := time.Now()
 		data, err := ser.MarshalUsers(users)
 		if err != nil {
@@ -110,11 +121,14 @@ func (c *Client) benchmarkSerializer(ser serializers.Serializer, users models.Us
 			return result, fmt.Errorf("SET operation failed: %w", err)
 		}
 		totalSetTime := time.Since(totalSetStart).Nanoseconds()
+		result.TotalSetAllocStats[i] = utils.TakeMemSnapshot().Since(setBefore)
 
+		result.DataSize = len(data)
 		result.SetTimes[i] = setTime
 		result.TotalSetTimes[i] = totalSetTime
 
 		// Measure total GET operation (GET + unmarshal)
+		getBefore := utils.TakeMemSnapshot()
 		totalGetStart := time.Now()
 
 		// Measure pure GET operation
@@ -131,6 +145,7 @@ func (c *Client) benchmarkSerializer(ser serializers.Serializer, users models.Us
 			return result, fmt.Errorf("failed to unmarshal retrieved users data: %w", err)
 		}
 		totalGetTime := time.Since(totalGetStart).Nanoseconds()
+		result.TotalGetAllocStats[i] = utils.TakeMemSnapshot().Since(getBefore)
 
 		result.GetTimes[i] = getTime
 		result.TotalGetTimes[i] = totalGetTime
@@ -151,6 +166,10 @@ func (c *Client) benchmarkSerializer(ser serializers.Serializer, users models.Us
 	result.TotalGetAvgNs = utils.CalculateAverage(result.TotalGetTimes)
 	result.TotalGetMedianNs = utils.CalculateMedian(result.TotalGetTimes)
 
+	// Calculate allocation statistics for total times
+	result.TotalSetAllocAvg = utils.AverageAllocStats(result.TotalSetAllocStats)
+	result.TotalGetAllocAvg = utils.AverageAllocStats(result.TotalGetAllocStats)
+
 	return result, nil
 }
 
@@ -0,0 +1,120 @@
+package reporter
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/models"
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/redis"
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/serializers"
+)
+
+// RunMetadata describes the environment and configuration of a benchmark run
+type RunMetadata struct {
+	Timestamp    time.Time
+	GoVersion    string
+	GOOS         string
+	GOARCH       string
+	GOMAXPROCS   int
+	NumCPU       int
+	CPUModel     string
+	Flags        map[string]string // command-line flags used for the run
+	DataCount    int
+	Seed         int64              // seed of the generated test data
+	Profile      models.DataProfile // shape of the generated test data
+	DataFile     string             // file the test data was loaded from instead of generated
+	Dependencies map[string]string  // module path -> version
+}
+
+// RunReport contains every result set of a benchmark run together with its metadata
+type RunReport struct {
+	Metadata      RunMetadata
+	Serialization []serializers.SerializationResult
+	PerRecord     []serializers.PerRecordResult
+	Compression   []serializers.CompressionResult
+	Stream        []serializers.StreamResult
+	Reuse         []serializers.ReuseResult
+	Partial       []serializers.PartialResult
+	Concurrency   []serializers.ConcurrencyResult
+	Fidelity      []serializers.FidelityResult
+	MetadataTypes []serializers.MetadataTypeResult
+	Corruption    []serializers.CorruptionResult
+	Symmetry      []serializers.SymmetryResult
+	Redis         []redis.RedisResult
+}
+
+// CollectMetadata gathers run metadata from the runtime, build info and /proc/cpuinfo
+func CollectMetadata(flags map[string]string, dataCount int, seed int64, profile models.DataProfile) RunMetadata {
+	metadata := RunMetadata{
+		Timestamp:    time.Now(),
+		GoVersion:    runtime.Version(),
+		GOOS:         runtime.GOOS,
+		GOARCH:       runtime.GOARCH,
+		GOMAXPROCS:   runtime.GOMAXPROCS(0),
+		NumCPU:       runtime.NumCPU(),
+		CPUModel:     readCPUModel(),
+		Flags:        flags,
+		DataCount:    dataCount,
+		Seed:         seed,
+		Profile:      profile,
+		Dependencies: make(map[string]string),
+	}
+
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, dep := range info.Deps {
+			version := dep.Version
+			if dep.Replace != nil {
+				version = fmt.Sprintf("%s => %s %s", dep.Version, dep.Replace.Path, dep.Replace.Version)
+			}
+			metadata.Dependencies[dep.Path] = version
+		}
+	}
+
+	return metadata
+}
+
+// readCPUModel returns the CPU model name from /proc/cpuinfo, or "unknown" if unavailable
+func readCPUModel() string {
+	file, err := os.Open("/proc/cpuinfo")
+	if err != nil {
+		return "unknown"
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		key, value, found := strings.Cut(scanner.Text(), ":")
+		if found && strings.TrimSpace(key) == "model name" {
+			return strings.TrimSpace(value)
+		}
+	}
+	return "unknown"
+}
+
+// SaveJSONReport saves all results of a run and its metadata to a single JSON document
+func (r *Reporter) SaveJSONReport(report RunReport) error {
+	filename := fmt.Sprintf("results_%s.json", time.Now().Format("20060102_150405"))
+	filepath := filepath.Join(r.outputDir, filename)
+
+	file, err := os.Create(filepath)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+
+	fmt.Printf("JSON report saved to: %s\n", filepath)
+	return nil
+}
@@ -5,17 +5,31 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/compare"
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/fidelity"
 	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/redis"
 	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/serializers"
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/utils"
 )
 
+// significanceLevel is the alpha used when comparing serializers pairwise
+const significanceLevel = 0.05
+
 // Reporter handles reporting of benchmark results
 type Reporter struct {
 	outputDir string
+	runInfo   []runInfoEntry
+}
+
+// runInfoEntry is a named value describing the run, appended to every CSV record
+type runInfoEntry struct {
+	name  string
+	value string
 }
 
 // NewReporter creates a new reporter
@@ -25,106 +39,1165 @@ func NewReporter(outputDir string) *Reporter {
 	}
 }
 
+// SetRunInfo records a named value describing the run (e.g. the test-data seed)
+// that is added as a column to every saved CSV file
+func (r *Reporter) SetRunInfo(name, value string) {
+	for i := range r.runInfo {
+		if r.runInfo[i].name == name {
+			r.runInfo[i].value = value
+			return
+		}
+	}
+	r.runInfo = append(r.runInfo, runInfoEntry{name: name, value: value})
+}
+
+// runInfoHeader returns the CSV header columns for the run info
+func (r *Reporter) runInfoHeader() []string {
+	header := make([]string, len(r.runInfo))
+	for i, entry := range r.runInfo {
+		header[i] = entry.name
+	}
+	return header
+}
+
+// runInfoRecord returns the CSV record columns for the run info
+func (r *Reporter) runInfoRecord() []string {
+	record := make([]string, len(r.runInfo))
+	for i, entry := range r.runInfo {
+		record[i] = entry.value
+	}
+	return record
+}
+
 // PrintSerializationResults prints serialization benchmark results to console
 func (r *Reporter) PrintSerializationResults(results []serializers.SerializationResult) {
 	fmt.Println("\n" + strings.Repeat("=", 120))
 	fmt.Println("SERIALIZATION BENCHMARK RESULTS")
 	fmt.Println(strings.Repeat("=", 120))
 
-	// Header
-	fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s | %-12s\n",
-		"Serializer", "Data Size", "Marshal Avg", "Marshal Med", "Unmarshal Avg", "Unmarshal Med")
-	fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s | %-12s\n",
-		"", "(MB)", "(ms)", "(ms)", "(ms)", "(ms)")
-	fmt.Println(strings.Repeat("-", 120))
+	// Header
+	fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s | %-12s\n",
+		"Serializer", "Data Size", "Marshal Avg", "Marshal Med", "Unmarshal Avg", "Unmarshal Med")
+	fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s | %-12s\n",
+		"", "(MB)", "(ms)", "(ms)", "(ms)", "(ms)")
+	fmt.Println(strings.Repeat("-", 120))
+
+	for _, result := range results {
+		fmt.Printf("%-12s | %-12.2f | %-12.2f | %-12.2f | %-12.2f | %-12.2f\n",
+			result.SerializerName,
+			float64(result.DataSize)/1000000.0,
+			float64(result.MarshalAvgNs)/1000000.0,
+			float64(result.MarshalMedianNs)/1000000.0,
+			float64(result.UnmarshalAvgNs)/1000000.0,
+			float64(result.UnmarshalMedianNs)/1000000.0)
+	}
+
+	fmt.Println()
+	fmt.Println("Allocations and GC (average per iteration):")
+	names := make([]string, len(results))
+	marshalAllocs := make([]utils.AllocStats, len(results))
+	unmarshalAllocs := make([]utils.AllocStats, len(results))
+	for i, result := range results {
+		names[i] = result.SerializerName
+		marshalAllocs[i] = result.MarshalAllocAvg
+		unmarshalAllocs[i] = result.UnmarshalAllocAvg
+	}
+	printAllocTable("Marshal", "Unmarshal", names, marshalAllocs, unmarshalAllocs)
+
+	fmt.Println()
+	fmt.Println("Marshal Time Statistics:")
+	marshalStats := make([]utils.Summary, len(results))
+	unmarshalStats := make([]utils.Summary, len(results))
+	for i, result := range results {
+		marshalStats[i] = result.MarshalStats
+		unmarshalStats[i] = result.UnmarshalStats
+	}
+	printSummaryTable(names, marshalStats)
+
+	fmt.Println()
+	fmt.Println("Unmarshal Time Statistics:")
+	printSummaryTable(names, unmarshalStats)
+	fmt.Println(strings.Repeat("=", 120))
+}
+
+// printSummaryTable prints spread, percentile and outlier statistics for each serializer
+func printSummaryTable(names []string, summaries []utils.Summary) {
+	fmt.Printf("%-12s | %-10s | %-10s | %-10s | %-10s | %-10s | %-10s | %-8s | %-8s\n",
+		"Serializer", "StdDev", "Min", "Max", "P90", "P95", "P99", "CV", "Outliers")
+	fmt.Printf("%-12s | %-10s | %-10s | %-10s | %-10s | %-10s | %-10s | %-8s | %-8s\n",
+		"", "(ms)", "(ms)", "(ms)", "(ms)", "(ms)", "(ms)", "(%)", "(count)")
+	fmt.Println(strings.Repeat("-", 120))
+
+	for i, name := range names {
+		s := summaries[i]
+		fmt.Printf("%-12s | %-10.2f | %-10.2f | %-10.2f | %-10.2f | %-10.2f | %-10.2f | %-8.1f | %-8d\n",
+			name,
+			s.StdDev/1000000.0,
+			float64(s.Min)/1000000.0,
+			float64(s.Max)/1000000.0,
+			float64(s.P90)/1000000.0,
+			float64(s.P95)/1000000.0,
+			float64(s.P99)/1000000.0,
+			s.CV*100,
+			len(s.Outliers))
+	}
+}
+
+// PrintSignificanceResults prints median confidence intervals and pairwise significance
+// matrices for marshal and unmarshal times
+func (r *Reporter) PrintSignificanceResults(results []serializers.SerializationResult) {
+	fmt.Println("\n" + strings.Repeat("=", 120))
+	fmt.Println("STATISTICAL COMPARISON")
+	fmt.Println(strings.Repeat("=", 120))
+
+	fmt.Println("Median 95% Confidence Intervals (bootstrap):")
+	fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s\n",
+		"Serializer", "Marshal Low", "Marshal High", "Unmarsh Low", "Unmarsh High")
+	fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s\n",
+		"", "(ms)", "(ms)", "(ms)", "(ms)")
+	fmt.Println(strings.Repeat("-", 120))
+
+	for _, result := range results {
+		fmt.Printf("%-12s | %-12.2f | %-12.2f | %-12.2f | %-12.2f\n",
+			result.SerializerName,
+			float64(result.MarshalMedianCI.Low)/1000000.0,
+			float64(result.MarshalMedianCI.High)/1000000.0,
+			float64(result.UnmarshalMedianCI.Low)/1000000.0,
+			float64(result.UnmarshalMedianCI.High)/1000000.0)
+	}
+
+	names := make([]string, len(results))
+	marshalTimes := make([][]int64, len(results))
+	unmarshalTimes := make([][]int64, len(results))
+	for i, result := range results {
+		names[i] = result.SerializerName
+		marshalTimes[i] = result.MarshalTimes
+		unmarshalTimes[i] = result.UnmarshalTimes
+	}
+
+	fmt.Println()
+	fmt.Printf("Marshal (row vs column, Mann-Whitney U, alpha=%.2f):\n", significanceLevel)
+	printSignificanceMatrix(names, marshalTimes)
+
+	fmt.Println()
+	fmt.Printf("Unmarshal (row vs column, Mann-Whitney U, alpha=%.2f):\n", significanceLevel)
+	printSignificanceMatrix(names, unmarshalTimes)
+
+	fmt.Println("\nfaster/slower: row is significantly faster/slower than column, ~: indistinguishable")
+	fmt.Println(strings.Repeat("=", 120))
+}
+
+// printSignificanceMatrix prints a pairwise significance matrix of timing samples
+func printSignificanceMatrix(names []string, samples [][]int64) {
+	fmt.Printf("%-12s", "")
+	for _, name := range names {
+		fmt.Printf(" | %-12s", name)
+	}
+	fmt.Println()
+	fmt.Println(strings.Repeat("-", 12+15*len(names)))
+
+	for i, name := range names {
+		fmt.Printf("%-12s", name)
+		for j := range names {
+			cell := "-"
+			if i != j {
+				switch utils.CompareSamples(samples[i], samples[j], significanceLevel) {
+				case -1:
+					cell = "faster"
+				case 1:
+					cell = "slower"
+				default:
+					cell = "~"
+				}
+			}
+			fmt.Printf(" | %-12s", cell)
+		}
+		fmt.Println()
+	}
+}
+
+// PrintPerRecordResults prints per-record benchmark results to console
+func (r *Reporter) PrintPerRecordResults(results []serializers.PerRecordResult) {
+	fmt.Println("\n" + strings.Repeat("=", 140))
+	fmt.Println("PER-RECORD BENCHMARK RESULTS")
+	fmt.Println(strings.Repeat("=", 140))
+
+	// Header
+	fmt.Printf("%-12s | %-10s | %-10s | %-12s | %-12s | %-8s | %-8s | %-8s | %-8s | %-8s\n",
+		"Serializer", "Marshal", "Unmarshal", "Marshal", "Unmarshal", "Size Min", "Size Avg", "Size P50", "Size P99", "Size Max")
+	fmt.Printf("%-12s | %-10s | %-10s | %-12s | %-12s | %-8s | %-8s | %-8s | %-8s | %-8s\n",
+		"", "(ns/op)", "(ns/op)", "(records/s)", "(records/s)", "(B)", "(B)", "(B)", "(B)", "(B)")
+	fmt.Println(strings.Repeat("-", 140))
+
+	for _, result := range results {
+		fmt.Printf("%-12s | %-10d | %-10d | %-12.0f | %-12.0f | %-8d | %-8d | %-8d | %-8d | %-8d\n",
+			result.SerializerName,
+			result.MarshalNsPerOp,
+			result.UnmarshalNsPerOp,
+			result.MarshalRecordsPerSec,
+			result.UnmarshalRecordsPerSec,
+			result.SizeMin,
+			result.SizeAvg,
+			result.SizeP50,
+			result.SizeP99,
+			result.SizeMax)
+	}
+
+	fmt.Println()
+	fmt.Println("Allocations per record:")
+	fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s\n",
+		"Serializer", "Marshal", "Marshal", "Unmarshal", "Unmarshal")
+	fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s\n",
+		"", "(B/op)", "(allocs/op)", "(B/op)", "(allocs/op)")
+	fmt.Println(strings.Repeat("-", 140))
+
+	for _, result := range results {
+		count := int64(max(result.RecordCount, 1))
+		fmt.Printf("%-12s | %-12d | %-12d | %-12d | %-12d\n",
+			result.SerializerName,
+			result.MarshalAllocAvg.Bytes/count,
+			result.MarshalAllocAvg.Allocs/count,
+			result.UnmarshalAllocAvg.Bytes/count,
+			result.UnmarshalAllocAvg.Allocs/count)
+	}
+	fmt.Println(strings.Repeat("=", 140))
+}
+
+// PrintCompressionResults prints raw and compressed sizes, entropy and ratios versus JSON
+func (r *Reporter) PrintCompressionResults(results []serializers.CompressionResult) {
+	fmt.Println("\n" + strings.Repeat("=", 120))
+	fmt.Println("COMPRESSION ANALYSIS")
+	fmt.Println(strings.Repeat("=", 120))
+	if len(results) == 0 {
+		return
+	}
+
+	// Summary
+	fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s | %-12s | %-12s\n",
+		"Serializer", "Raw Size", "Entropy", "Raw Size", "Best Codec", "Best Size", "Best Size")
+	fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s | %-12s | %-12s\n",
+		"", "(MB)", "(bits/byte)", "(vs JSON)", "", "(MB)", "(vs JSON)")
+	fmt.Println(strings.Repeat("-", 120))
+
+	for _, result := range results {
+		best := serializers.CompressedSize{}
+		for i, c := range result.Compressed {
+			if i == 0 || c.Size < best.Size {
+				best = c
+			}
+		}
+		fmt.Printf("%-12s | %-12.2f | %-12.2f | %-12.2f | %-12s | %-12.2f | %-12.2f\n",
+			result.SerializerName,
+			float64(result.RawSize)/1000000.0,
+			result.Entropy,
+			result.RawRatioVsJSON,
+			best.Codec,
+			float64(best.Size)/1000000.0,
+			best.RatioVsJSON)
+	}
+
+	codecs := make([]string, len(results[0].Compressed))
+	for i, c := range results[0].Compressed {
+		codecs[i] = c.Codec
+	}
+
+	fmt.Println()
+	fmt.Println("Compressed size (MB):")
+	printCompressionTable(codecs, results, func(c serializers.CompressedSize) float64 {
+		return float64(c.Size) / 1000000.0
+	})
+
+	fmt.Println()
+	fmt.Println("Compressed size relative to JSON under the same codec and level:")
+	printCompressionTable(codecs, results, func(c serializers.CompressedSize) float64 {
+		return c.RatioVsJSON
+	})
+	fmt.Println(strings.Repeat("=", 120))
+}
+
+// printCompressionTable prints one value per serializer and codec
+func printCompressionTable(codecs []string, results []serializers.CompressionResult, value func(serializers.CompressedSize) float64) {
+	fmt.Printf("%-12s", "Serializer")
+	for _, codec := range codecs {
+		fmt.Printf(" | %-8s", codec)
+	}
+	fmt.Println()
+	fmt.Println(strings.Repeat("-", 120))
+
+	for _, result := range results {
+		fmt.Printf("%-12s", result.SerializerName)
+		for _, c := range result.Compressed {
+			fmt.Printf(" | %-8.2f", value(c))
+		}
+		fmt.Println()
+	}
+}
+
+// PrintStreamResults prints streaming benchmark results to console
+func (r *Reporter) PrintStreamResults(results []serializers.StreamResult) {
+	fmt.Println("\n" + strings.Repeat("=", 120))
+	fmt.Println("STREAMING BENCHMARK RESULTS")
+	fmt.Println(strings.Repeat("=", 120))
+	if len(results) > 0 {
+		fmt.Printf("Records: %d (generation time excluded from encode times: %.2f ms)\n",
+			results[0].RecordCount, float64(results[0].GenerationNs)/1000000.0)
+	}
+
+	// Header
+	fmt.Printf("%-12s | %-10s | %-10s | %-10s | %-12s | %-12s | %-10s | %-10s | %-10s | %-10s\n",
+		"Serializer", "Stream", "Encode", "Decode", "Encode", "Decode", "Encode", "Decode", "Encode", "Decode")
+	fmt.Printf("%-12s | %-10s | %-10s | %-10s | %-12s | %-12s | %-10s | %-10s | %-10s | %-10s\n",
+		"", "Size (MB)", "Med (ms)", "Med (ms)", "(records/s)", "(records/s)", "(MB/s)", "(MB/s)", "Peak (MB)", "Peak (MB)")
+	fmt.Println(strings.Repeat("-", 120))
+
+	for _, result := range results {
+		fmt.Printf("%-12s | %-10.2f | %-10.2f | %-10.2f | %-12.0f | %-12.0f | %-10.2f | %-10.2f | %-10.2f | %-10.2f\n",
+			result.SerializerName,
+			float64(result.StreamSize)/1000000.0,
+			float64(result.EncodeMedianNs)/1000000.0,
+			float64(result.DecodeMedianNs)/1000000.0,
+			result.EncodeRecordsPerSec,
+			result.DecodeRecordsPerSec,
+			result.EncodeMBPerSec,
+			result.DecodeMBPerSec,
+			float64(result.EncodePeakHeap)/1000000.0,
+			float64(result.DecodePeakHeap)/1000000.0)
+	}
+	fmt.Println(strings.Repeat("=", 120))
+}
+
+// PrintReuseResults prints per-record times and allocations of the reuse APIs next to
+// allocate-per-call Marshal/Unmarshal
+func (r *Reporter) PrintReuseResults(results []serializers.ReuseResult) {
+	fmt.Println("\n" + strings.Repeat("=", 120))
+	fmt.Println("BUFFER REUSE BENCHMARK RESULTS")
+	fmt.Println(strings.Repeat("=", 120))
+
+	fmt.Println("Marshal vs AppendMarshal (one buffer reused for all records):")
+	printReuseTable("Marshal", "Append", results, func(result serializers.ReuseResult) (bool, int64, int64, utils.AllocStats, utils.AllocStats) {
+		return result.AppendMarshal, result.MarshalNsPerOp, result.AppendNsPerOp, result.MarshalAllocAvg, result.AppendAllocAvg
+	})
+
+	fmt.Println()
+	fmt.Println("Unmarshal vs UnmarshalInto (one user reused for all records):")
+	printReuseTable("Unmarshal", "Into", results, func(result serializers.ReuseResult) (bool, int64, int64, utils.AllocStats, utils.AllocStats) {
+		return result.UnmarshalInto, result.UnmarshalNsPerOp, result.IntoNsPerOp, result.UnmarshalAllocAvg, result.IntoAllocAvg
+	})
+
+	fmt.Println("\nSpeedup: allocate-per-call time / reuse time, -: reuse API not implemented")
+	fmt.Println(strings.Repeat("=", 120))
+}
+
+// printReuseTable prints the per-record comparison of an allocate-per-call operation and its
+// reuse variant. side returns whether the variant is implemented and the times and allocations
+// of both operations.
+func printReuseTable(baseOp, reuseOp string, results []serializers.ReuseResult,
+	side func(serializers.ReuseResult) (bool, int64, int64, utils.AllocStats, utils.AllocStats)) {
+	fmt.Printf("%-12s | %-10s | %-10s | %-8s | %-10s | %-10s | %-12s | %-12s\n",
+		"Serializer", baseOp, reuseOp, "Speedup", baseOp, reuseOp, baseOp, reuseOp)
+	fmt.Printf("%-12s | %-10s | %-10s | %-8s | %-10s | %-10s | %-12s | %-12s\n",
+		"", "(ns/op)", "(ns/op)", "", "(B/op)", "(B/op)", "(allocs/op)", "(allocs/op)")
+	fmt.Println(strings.Repeat("-", 120))
+
+	for _, result := range results {
+		count := int64(max(result.RecordCount, 1))
+		implemented, baseNs, reuseNs, baseAlloc, reuseAlloc := side(result)
+		if !implemented {
+			fmt.Printf("%-12s | %-10d | %-10s | %-8s | %-10d | %-10s | %-12d | %-12s\n",
+				result.SerializerName, baseNs, "-", "-", baseAlloc.Bytes/count, "-", baseAlloc.Allocs/count, "-")
+			continue
+		}
+		speedup := 0.0
+		if reuseNs > 0 {
+			speedup = float64(baseNs) / float64(reuseNs)
+		}
+		fmt.Printf("%-12s | %-10d | %-10d | %-8.2f | %-10d | %-10d | %-12d | %-12d\n",
+			result.SerializerName, baseNs, reuseNs, speedup,
+			baseAlloc.Bytes/count, reuseAlloc.Bytes/count, baseAlloc.Allocs/count, reuseAlloc.Allocs/count)
+	}
+}
+
+// PrintPartialResults prints per-record times and allocations of ReadFields next to a full
+// Unmarshal of the same records
+func (r *Reporter) PrintPartialResults(results []serializers.PartialResult) {
+	fmt.Println("\n" + strings.Repeat("=", 120))
+	fmt.Println("PARTIAL READ BENCHMARK RESULTS")
+	fmt.Println(strings.Repeat("=", 120))
+	if len(results) > 0 {
+		fmt.Printf("Fields: %s\n", strings.Join(results[0].Fields, ", "))
+	}
+
+	fmt.Printf("%-12s | %-10s | %-10s | %-8s | %-10s | %-10s | %-12s | %-12s\n",
+		"Serializer", "Unmarshal", "ReadFields", "Speedup", "Unmarshal", "ReadFields", "Unmarshal", "ReadFields")
+	fmt.Printf("%-12s | %-10s | %-10s | %-8s | %-10s | %-10s | %-12s | %-12s\n",
+		"", "(ns/op)", "(ns/op)", "", "(B/op)", "(B/op)", "(allocs/op)", "(allocs/op)")
+	fmt.Println(strings.Repeat("-", 120))
+
+	for _, result := range results {
+		count := int64(max(result.RecordCount, 1))
+		speedup := 0.0
+		if result.ReadNsPerOp > 0 {
+			speedup = float64(result.UnmarshalNsPerOp) / float64(result.ReadNsPerOp)
+		}
+		fmt.Printf("%-12s | %-10d | %-10d | %-8.2f | %-10d | %-10d | %-12d | %-12d\n",
+			result.SerializerName, result.UnmarshalNsPerOp, result.ReadNsPerOp, speedup,
+			result.UnmarshalAllocAvg.Bytes/count, result.ReadAllocAvg.Bytes/count,
+			result.UnmarshalAllocAvg.Allocs/count, result.ReadAllocAvg.Allocs/count)
+	}
+
+	fmt.Println("\nSpeedup: full Unmarshal time / ReadFields time; serializers without ReadFields are skipped")
+	fmt.Println(strings.Repeat("=", 120))
+}
+
+// PrintConcurrencyResults prints aggregate throughput, latency percentiles and scaling
+// efficiency of concurrent per-record operations
+func (r *Reporter) PrintConcurrencyResults(results []serializers.ConcurrencyResult) {
+	fmt.Println("\n" + strings.Repeat("=", 140))
+	fmt.Println("CONCURRENCY BENCHMARK RESULTS")
+	fmt.Println(strings.Repeat("=", 140))
+	if len(results) > 0 {
+		fmt.Printf("Duration per operation and goroutine count: %.1f s\n", float64(results[0].DurationNs)/1e9)
+	}
+
+	// Header
+	fmt.Printf("%-12s | %-10s | %-12s | %-10s | %-10s | %-10s | %-12s | %-10s | %-10s | %-10s\n",
+		"Serializer", "Goroutines", "Marshal", "Marshal", "Marshal", "Marshal", "Unmarshal", "Unmarshal", "Unmarshal", "Unmarshal")
+	fmt.Printf("%-12s | %-10s | %-12s | %-10s | %-10s | %-10s | %-12s | %-10s | %-10s | %-10s\n",
+		"", "", "(ops/s)", "Efficiency", "P50 (ns)", "P99 (ns)", "(ops/s)", "Efficiency", "P50 (ns)", "P99 (ns)")
+	fmt.Println(strings.Repeat("-", 140))
+
+	for i, result := range results {
+		if i > 0 && result.SerializerName != results[i-1].SerializerName {
+			fmt.Println(strings.Repeat("-", 140))
+		}
+		fmt.Printf("%-12s | %-10d | %-12.0f | %-10.2f | %-10d | %-10d | %-12.0f | %-10.2f | %-10d | %-10d\n",
+			result.SerializerName,
+			result.Workers,
+			result.MarshalOpsPerSec,
+			result.MarshalEfficiency,
+			result.MarshalLatency.P50,
+			result.MarshalLatency.P99,
+			result.UnmarshalOpsPerSec,
+			result.UnmarshalEfficiency,
+			result.UnmarshalLatency.P50,
+			result.UnmarshalLatency.P99)
+	}
+
+	fmt.Println("\nEfficiency: ops/s divided by goroutines x single-goroutine ops/s (1.00 = linear scaling)")
+	fmt.Println(strings.Repeat("=", 140))
+}
+
+// PrintFidelityResults prints a field path x serializer matrix of round-trip fidelity
+func (r *Reporter) PrintFidelityResults(results []serializers.FidelityResult) {
+	width := 12 + 15*len(results)
+	paths := fidelityPaths(results)
+	pathWidth := 10
+	for _, path := range paths {
+		pathWidth = max(pathWidth, len(path))
+	}
+	width += pathWidth
+
+	fmt.Println("\n" + strings.Repeat("=", width))
+	fmt.Println("FIELD-LEVEL ROUND-TRIP FIDELITY RESULTS")
+	fmt.Println(strings.Repeat("=", width))
+
+	// Summary
+	fmt.Printf("%-12s | %-10s | %-10s | %-10s | %-10s\n", "Serializer", "Users", "Errors", "Fields OK", "Fields Lost")
+	fmt.Println(strings.Repeat("-", width))
+	for _, result := range results {
+		ok := 0
+		for _, f := range result.Fields {
+			if f.OK() {
+				ok++
+			}
+		}
+		fmt.Printf("%-12s | %-10d | %-10d | %-10d | %-10d\n",
+			result.SerializerName, result.Users, result.Errors, ok, len(result.Fields)-ok)
+	}
+
+	// Matrix
+	fmt.Println()
+	fmt.Printf("%-*s", pathWidth, "Field")
+	for _, result := range results {
+		fmt.Printf(" | %-12s", result.SerializerName)
+	}
+	fmt.Println()
+	fmt.Println(strings.Repeat("-", width))
+
+	for _, path := range paths {
+		fmt.Printf("%-*s", pathWidth, path)
+		for _, result := range results {
+			i := slices.IndexFunc(result.Fields, func(f fidelity.Field) bool { return f.Path == path })
+			cell := "-"
+			if i >= 0 {
+				cell = fidelityCell(result.Fields[i])
+			}
+			fmt.Printf(" | %-12s", cell)
+		}
+		fmt.Println()
+	}
+
+	fmt.Println("\n✓: preserved, value/type/nil/location: aspect lost (with the share of values if not all), -: not present")
+	for _, result := range results {
+		if result.FirstError != "" {
+			fmt.Printf("%s: %d errors, first: %s\n", result.SerializerName, result.Errors, result.FirstError)
+		}
+	}
+	fmt.Println(strings.Repeat("=", width))
+}
+
+// fidelityPaths returns the union of the field paths of all results, keeping each
+// result's order
+func fidelityPaths(results []serializers.FidelityResult) []string {
+	var paths []string
+	for _, result := range results {
+		prev := -1
+		for _, f := range result.Fields {
+			i := slices.Index(paths, f.Path)
+			if i < 0 {
+				i = prev + 1
+				paths = slices.Insert(paths, i, f.Path)
+			}
+			prev = i
+		}
+	}
+	return paths
+}
+
+// fidelityCell returns the matrix cell of a field: ✓ or the lost aspects
+func fidelityCell(f fidelity.Field) string {
+	if f.OK() {
+		return "✓"
+	}
+	var lost []string
+	for _, aspect := range fidelity.Aspects {
+		if f.Lost[aspect] > 0 {
+			lost = append(lost, string(aspect))
+		}
+	}
+	cell := strings.Join(lost, "+")
+	if n := f.LostCount(); n < f.Compared {
+		cell += fmt.Sprintf(" %d%%", n*100/f.Compared)
+	}
+	return cell
+}
+
+// PrintMetadataTypeResults prints a Metadata value kind x serializer matrix of restored Go types
+func (r *Reporter) PrintMetadataTypeResults(results []serializers.MetadataTypeResult) {
+	width := 12 + 19*len(results)
+	fmt.Println("\n" + strings.Repeat("=", width))
+	fmt.Println("METADATA TYPE FIDELITY RESULTS")
+	fmt.Println(strings.Repeat("=", width))
+	if len(results) == 0 {
+		return
+	}
+
+	fmt.Printf("%-12s", "Kind")
+	for _, result := range results {
+		fmt.Printf(" | %-16s", result.SerializerName)
+	}
+	fmt.Println()
+	fmt.Println(strings.Repeat("-", width))
+
+	for i, check := range results[0].Checks {
+		fmt.Printf("%-12s", check.Kind)
+		for _, result := range results {
+			fmt.Printf(" | %-16s", metadataTypeCell(result.Checks[i]))
+		}
+		fmt.Println()
+	}
+
+	fmt.Println("\ntype: restored with the same type, type*: converted without changing the value,")
+	fmt.Println("type!: lossy conversion, dropped: key missing, error: marshal/unmarshal failed")
+	fmt.Println(strings.Repeat("=", width))
+}
+
+// metadataTypeCell returns the matrix cell of a Metadata value round trip
+func metadataTypeCell(check serializers.MetadataTypeCheck) string {
+	switch check.Outcome {
+	case serializers.MetadataConverted:
+		return check.RestoredType + "*"
+	case serializers.MetadataLossy:
+		return check.RestoredType + "!"
+	case serializers.MetadataExact:
+		return check.RestoredType
+	default:
+		return check.Outcome
+	}
+}
+
+// PrintCorruptionResults prints how each serializer's Unmarshal handles damaged encodings, in
+// total and as the share of silently wrong data per kind of damage
+func (r *Reporter) PrintCorruptionResults(results []serializers.CorruptionResult) {
+	fmt.Println("\n" + strings.Repeat("=", 100))
+	fmt.Println("CORRUPTION ROBUSTNESS RESULTS")
+	fmt.Println(strings.Repeat("=", 100))
+	if len(results) == 0 {
+		return
+	}
+
+	fmt.Println("All damages (% of trials):")
+	fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s\n", "Serializer", "Error", "Panic", "Wrong", "Correct")
+	fmt.Println(strings.Repeat("-", 100))
+
+	for _, result := range results {
+		var total serializers.CorruptionCounts
+		for _, counts := range result.Damages {
+			total.Trials += counts.Trials
+			total.Errors += counts.Errors
+			total.Panics += counts.Panics
+			total.Wrong += counts.Wrong
+			total.Correct += counts.Correct
+		}
+		fmt.Printf("%-12s | %-12.1f | %-12.1f | %-12.1f | %-12.1f\n",
+			result.SerializerName,
+			percentOf(total.Errors, total.Trials),
+			percentOf(total.Panics, total.Trials),
+			percentOf(total.Wrong, total.Trials),
+			percentOf(total.Correct, total.Trials))
+	}
+
+	fmt.Println()
+	fmt.Println("Silently wrong data per damage (% of trials):")
+	fmt.Printf("%-12s", "Serializer")
+	for _, counts := range results[0].Damages {
+		fmt.Printf(" | %-12s", counts.Damage)
+	}
+	fmt.Println()
+	fmt.Println(strings.Repeat("-", 100))
+
+	for _, result := range results {
+		fmt.Printf("%-12s", result.SerializerName)
+		for _, counts := range result.Damages {
+			fmt.Printf(" | %-12.1f", percentOf(counts.Wrong, counts.Trials))
+		}
+		fmt.Println()
+	}
+
+	fmt.Println("\nError: Unmarshal failed cleanly, Panic: Unmarshal panicked (recovered here or by the serializer),")
+	fmt.Println("Wrong: decoded without error but differs from the undamaged data, Correct: decoded unchanged")
+	fmt.Println(strings.Repeat("=", 100))
+}
+
+// percentOf returns n as a percentage of total, or 0 when total is zero
+func percentOf(n, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(n) / float64(total) * 100
+}
+
+// PrintSymmetryResults prints symmetry test results to console
+func (r *Reporter) PrintSymmetryResults(results []serializers.SymmetryResult) {
+	fmt.Println("\n" + strings.Repeat("=", 100))
+	fmt.Println("STRICT TYPE PRESERVATION TEST RESULTS")
+	fmt.Println(strings.Repeat("=", 100))
+
+	fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s\n",
+		"Serializer", "Empty→Empty", "Empty{}→{}", "Nil→Nil", "Nil→Nil")
+	fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s\n",
+		"", "(Slices)", "(Maps)", "(Slices)", "(Maps)")
+	fmt.Println(strings.Repeat("-", 100))
+
+	for _, result := range results {
+		fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s\n",
+			result.SerializerName,
+			boolToString(result.StrictEmptySlicesOK),
+			boolToString(result.StrictEmptyMapsOK),
+			boolToString(result.StrictNilSlicesOK),
+			boolToString(result.StrictNilMapsOK))
+	}
+
+	fmt.Println()
+	fmt.Println("time.Time:")
+	fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s | %-12s | %-12s\n",
+		"Serializer", "Nanoseconds", "Location", "Zero", "Range", "Equal", "==")
+	fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s | %-12s | %-12s\n",
+		"", "", "(Asia/Tokyo)", "(IsZero)", "(1969/9999)", "(all)", "(non-Tokyo)")
+	fmt.Println(strings.Repeat("-", 100))
+
+	for _, result := range results {
+		fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s | %-12s | %-12s\n",
+			result.SerializerName,
+			boolToString(result.TimeNanosecondsOK),
+			boolToString(result.TimeLocationOK),
+			boolToString(result.TimeZeroOK),
+			boolToString(result.TimeRangeOK),
+			boolToString(result.TimeEqualOK),
+			boolToString(result.TimeIdenticalOK))
+	}
+
+	fmt.Println()
+	fmt.Println("Numbers:")
+	fmt.Printf("%-12s | %-12s | %-12s | %-12s\n", "Serializer", "int64", "int32", "2^53+1")
+	fmt.Printf("%-12s | %-12s | %-12s | %-12s\n", "", "(ID max/min)", "(Age/Limits)", "(ID/Metadata)")
+	fmt.Println(strings.Repeat("-", 100))
+
+	for _, result := range results {
+		fmt.Printf("%-12s | %-12s | %-12s | %-12s\n",
+			result.SerializerName, result.NumericInt64, result.NumericInt32, result.NumericFloat53)
+	}
+
+	fmt.Println()
+	fmt.Println("Strings:")
+	fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s | %-12s | %-12s\n",
+		"Serializer", "Multi-byte", "Emoji ZWJ", "Invalid", "NUL", "HTML", "Long")
+	fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s | %-12s | %-12s\n",
+		"", "", "", "(UTF-8)", "", "(<>&)", "(1 MiB)")
+	fmt.Println(strings.Repeat("-", 100))
+
+	for _, result := range results {
+		fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s | %-12s | %-12s\n",
+			result.SerializerName, result.StringMultiByte, result.StringEmojiZWJ, result.StringInvalidUTF8,
+			result.StringNUL, result.StringHTML, result.StringLong)
+	}
+
+	fmt.Println(strings.Repeat("=", 100))
+
+	// Print details
+	fmt.Println("\nDetails:")
+	for _, result := range results {
+		fmt.Printf("%-12s: %s\n", result.SerializerName, result.Details)
+	}
+}
+
+// PrintRedisResults prints Redis benchmark results to console
+func (r *Reporter) PrintRedisResults(results []redis.RedisResult) {
+	fmt.Println("\n" + strings.Repeat("=", 100))
+	fmt.Println("REDIS PERFORMANCE RESULTS")
+	fmt.Println(strings.Repeat("=", 100))
+
+	// First table: Total time (including serialization)
+	fmt.Println("Total Time (including serialization):")
+	fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s | %-12s\n",
+		"Serializer", "Data Size", "SET Avg", "SET Med", "GET Avg", "GET Med")
+	fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s | %-12s\n",
+		"", "(MB)", "(ms)", "(ms)", "(ms)", "(ms)")
+	fmt.Println(strings.Repeat("-", 100))
+
+	for _, result := range results {
+		fmt.Printf("%-12s | %-12.2f | %-12.2f | %-12.2f | %-12.2f | %-12.2f\n",
+			result.SerializerName,
+			float64(result.DataSize)/1000000.0,
+			float64(result.TotalSetAvgNs)/1000000.0,
+			float64(result.TotalSetMedianNs)/1000000.0,
+			float64(result.TotalGetAvgNs)/1000000.0,
+			float64(result.TotalGetMedianNs)/1000000.0)
+	}
+
+	fmt.Println()
+	fmt.Println("Pure I/O Time (Redis operations only):")
+	fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s\n",
+		"Serializer", "SET Avg", "SET Med", "GET Avg", "GET Med")
+	fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s\n",
+		"", "(ms)", "(ms)", "(ms)", "(ms)")
+	fmt.Println(strings.Repeat("-", 100))
+
+	for _, result := range results {
+		fmt.Printf("%-12s | %-12.2f | %-12.2f | %-12.2f | %-12.2f\n",
+			result.SerializerName,
+			float64(result.SetAvgNs)/1000000.0,
+			float64(result.SetMedianNs)/1000000.0,
+			float64(result.GetAvgNs)/1000000.0,
+			float64(result.GetMedianNs)/1000000.0)
+	}
+
+	fmt.Println()
+	fmt.Println("Allocations and GC (including serialization, average per iteration):")
+	names := make([]string, len(results))
+	setAllocs := make([]utils.AllocStats, len(results))
+	getAllocs := make([]utils.AllocStats, len(results))
+	for i, result := range results {
+		names[i] = result.SerializerName
+		setAllocs[i] = result.TotalSetAllocAvg
+		getAllocs[i] = result.TotalGetAllocAvg
+	}
+	printAllocTable("SET", "GET", names, setAllocs, getAllocs)
+	fmt.Println(strings.Repeat("=", 100))
+}
+
+// printAllocTable prints allocation and GC statistics of two operations for each serializer
+func printAllocTable(firstOp, secondOp string, names []string, first, second []utils.AllocStats) {
+	fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s | %-12s | %-12s | %-12s | %-12s\n",
+		"Serializer", firstOp, firstOp, firstOp, firstOp, secondOp, secondOp, secondOp, secondOp)
+	fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s | %-12s | %-12s | %-12s | %-12s\n",
+		"", "Alloc (MB)", "Allocs", "GC Cycles", "Pause (ms)", "Alloc (MB)", "Allocs", "GC Cycles", "Pause (ms)")
+	fmt.Println(strings.Repeat("-", 132))
+
+	for i, name := range names {
+		fmt.Printf("%-12s | %-12.2f | %-12d | %-12d | %-12.3f | %-12.2f | %-12d | %-12d | %-12.3f\n",
+			name,
+			float64(first[i].Bytes)/1000000.0,
+			first[i].Allocs,
+			first[i].GCCycles,
+			float64(first[i].GCPauseNs)/1000000.0,
+			float64(second[i].Bytes)/1000000.0,
+			second[i].Allocs,
+			second[i].GCCycles,
+			float64(second[i].GCPauseNs)/1000000.0)
+	}
+}
+
+// PrintComparison prints the deltas between a baseline run and the current run
+func (r *Reporter) PrintComparison(comparison compare.Comparison) {
+	fmt.Println("\n" + strings.Repeat("=", 120))
+	fmt.Printf("BASELINE COMPARISON (regression threshold: +%.1f%%)\n", comparison.Threshold*100)
+	fmt.Println(strings.Repeat("=", 120))
+
+	fmt.Printf("%-12s | %-12s | %-12s | %-9s | %-12s | %-12s | %-9s | %-12s | %-12s | %-9s | %-s\n",
+		"Serializer", "Size Base", "Size New", "Size", "Marshal Base", "Marshal New", "Marshal",
+		"Unmarsh Base", "Unmarsh New", "Unmarsh", "Status")
+	fmt.Printf("%-12s | %-12s | %-12s | %-9s | %-12s | %-12s | %-9s | %-12s | %-12s | %-9s | %-s\n",
+		"", "(MB)", "(MB)", "(Δ%)", "(ms)", "(ms)", "(Δ%)", "(ms)", "(ms)", "(Δ%)", "")
+	fmt.Println(strings.Repeat("-", 120))
+
+	for _, d := range comparison.Deltas {
+		status := "OK"
+		if len(d.Regressions) > 0 {
+			status = "REGRESSION: " + strings.Join(d.Regressions, ", ")
+		}
+		fmt.Printf("%-12s | %-12.2f | %-12.2f | %-+9.1f | %-12.2f | %-12.2f | %-+9.1f | %-12.2f | %-12.2f | %-+9.1f | %s\n",
+			d.SerializerName,
+			float64(d.Base.DataSize)/1000000.0,
+			float64(d.Current.DataSize)/1000000.0,
+			d.SizeChange*100,
+			float64(d.Base.MarshalMedianNs)/1000000.0,
+			float64(d.Current.MarshalMedianNs)/1000000.0,
+			d.MarshalChange*100,
+			float64(d.Base.UnmarshalMedianNs)/1000000.0,
+			float64(d.Current.UnmarshalMedianNs)/1000000.0,
+			d.UnmarshalChange*100,
+			status)
+	}
+	fmt.Println(strings.Repeat("=", 120))
+
+	if len(comparison.OnlyBase) > 0 {
+		fmt.Printf("Only in baseline: %s\n", strings.Join(comparison.OnlyBase, ", "))
+	}
+	if len(comparison.OnlyCurrent) > 0 {
+		fmt.Printf("Only in new run: %s\n", strings.Join(comparison.OnlyCurrent, ", "))
+	}
+}
+
+// SaveSerializationResults saves serialization results to CSV
+func (r *Reporter) SaveSerializationResults(results []serializers.SerializationResult) error {
+	filename := fmt.Sprintf("serialization_results_%s.csv", time.Now().Format("20060102_150405"))
+	filepath := filepath.Join(r.outputDir, filename)
+
+	file, err := os.Create(filepath)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	// Write header
+	header := []string{
+		"Serializer", "DataSize_Bytes", "DataSize_MB", "MarshalAvg_ns", "MarshalMedian_ns",
+		"UnmarshalAvg_ns", "UnmarshalMedian_ns", "MarshalAvg_ms", "MarshalMedian_ms",
+		"UnmarshalAvg_ms", "UnmarshalMedian_ms",
+	}
+	header = append(header, allocStatsHeader("Marshal")...)
+	header = append(header, allocStatsHeader("Unmarshal")...)
+	header = append(header, summaryHeader("Marshal")...)
+	header = append(header, summaryHeader("Unmarshal")...)
+	header = append(header,
+		"MarshalMedianCILow_ns", "MarshalMedianCIHigh_ns", "UnmarshalMedianCILow_ns", "UnmarshalMedianCIHigh_ns")
+	header = append(header, r.runInfoHeader()...)
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write header: %w", err)
+	}
+
+	// Write data
+	for _, result := range results {
+		record := []string{
+			result.SerializerName,
+			strconv.Itoa(result.DataSize),
+			fmt.Sprintf("%.2f", float64(result.DataSize)/1000000.0),
+			strconv.FormatInt(result.MarshalAvgNs, 10),
+			strconv.FormatInt(result.MarshalMedianNs, 10),
+			strconv.FormatInt(result.UnmarshalAvgNs, 10),
+			strconv.FormatInt(result.UnmarshalMedianNs, 10),
+			fmt.Sprintf("%.2f", float64(result.MarshalAvgNs)/1000000.0),
+			fmt.Sprintf("%.2f", float64(result.MarshalMedianNs)/1000000.0),
+			fmt.Sprintf("%.2f", float64(result.UnmarshalAvgNs)/1000000.0),
+			fmt.Sprintf("%.2f", float64(result.UnmarshalMedianNs)/1000000.0),
+		}
+		record = append(record, allocStatsRecord(result.MarshalAllocAvg)...)
+		record = append(record, allocStatsRecord(result.UnmarshalAllocAvg)...)
+		record = append(record, summaryRecord(result.MarshalStats)...)
+		record = append(record, summaryRecord(result.UnmarshalStats)...)
+		record = append(record,
+			strconv.FormatInt(result.MarshalMedianCI.Low, 10),
+			strconv.FormatInt(result.MarshalMedianCI.High, 10),
+			strconv.FormatInt(result.UnmarshalMedianCI.Low, 10),
+			strconv.FormatInt(result.UnmarshalMedianCI.High, 10))
+		record = append(record, r.runInfoRecord()...)
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write record: %w", err)
+		}
+	}
+
+	fmt.Printf("Serialization results saved to: %s\n", filepath)
+	return nil
+}
+
+// SaveCompressionResults saves compression analysis results to CSV
+func (r *Reporter) SaveCompressionResults(results []serializers.CompressionResult) error {
+	filename := fmt.Sprintf("compression_results_%s.csv", time.Now().Format("20060102_150405"))
+	filepath := filepath.Join(r.outputDir, filename)
+
+	file, err := os.Create(filepath)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	// Write header
+	header := []string{"Serializer", "RawSize_Bytes", "Entropy_BitsPerByte", "RawRatioVsJSON"}
+	if len(results) > 0 {
+		for _, c := range results[0].Compressed {
+			prefix := strings.ReplaceAll(c.Codec, ":", "_L")
+			header = append(header, prefix+"_Bytes", prefix+"_Ratio", prefix+"_RatioVsJSON")
+		}
+	}
+	header = append(header, r.runInfoHeader()...)
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write header: %w", err)
+	}
+
+	// Write data
+	for _, result := range results {
+		record := []string{
+			result.SerializerName,
+			strconv.Itoa(result.RawSize),
+			fmt.Sprintf("%.4f", result.Entropy),
+			fmt.Sprintf("%.4f", result.RawRatioVsJSON),
+		}
+		for _, c := range result.Compressed {
+			record = append(record,
+				strconv.Itoa(c.Size),
+				fmt.Sprintf("%.4f", c.Ratio),
+				fmt.Sprintf("%.4f", c.RatioVsJSON))
+		}
+		record = append(record, r.runInfoRecord()...)
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write record: %w", err)
+		}
+	}
+
+	fmt.Printf("Compression results saved to: %s\n", filepath)
+	return nil
+}
+
+// SaveStreamResults saves streaming benchmark results to CSV
+func (r *Reporter) SaveStreamResults(results []serializers.StreamResult) error {
+	filename := fmt.Sprintf("stream_results_%s.csv", time.Now().Format("20060102_150405"))
+	filepath := filepath.Join(r.outputDir, filename)
+
+	file, err := os.Create(filepath)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	// Write header
+	header := []string{
+		"Serializer", "RecordCount", "StreamSize_Bytes", "Generation_ns",
+		"EncodeMedian_ns", "DecodeMedian_ns",
+		"EncodeRecordsPerSec", "DecodeRecordsPerSec",
+		"EncodeMBPerSec", "DecodeMBPerSec",
+		"EncodePeakHeap_Bytes", "DecodePeakHeap_Bytes",
+	}
+	header = append(header, r.runInfoHeader()...)
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write header: %w", err)
+	}
 
+	// Write data
 	for _, result := range results {
-		fmt.Printf("%-12s | %-12.2f | %-12.2f | %-12.2f | %-12.2f | %-12.2f\n",
+		record := []string{
 			result.SerializerName,
-			float64(result.DataSize)/1000000.0,
-			float64(result.MarshalAvgNs)/1000000.0,
-			float64(result.MarshalMedianNs)/1000000.0,
-			float64(result.UnmarshalAvgNs)/1000000.0,
-			float64(result.UnmarshalMedianNs)/1000000.0)
+			strconv.Itoa(result.RecordCount),
+			strconv.FormatInt(result.StreamSize, 10),
+			strconv.FormatInt(result.GenerationNs, 10),
+			strconv.FormatInt(result.EncodeMedianNs, 10),
+			strconv.FormatInt(result.DecodeMedianNs, 10),
+			fmt.Sprintf("%.0f", result.EncodeRecordsPerSec),
+			fmt.Sprintf("%.0f", result.DecodeRecordsPerSec),
+			fmt.Sprintf("%.2f", result.EncodeMBPerSec),
+			fmt.Sprintf("%.2f", result.DecodeMBPerSec),
+			strconv.FormatUint(result.EncodePeakHeap, 10),
+			strconv.FormatUint(result.DecodePeakHeap, 10),
+		}
+		record = append(record, r.runInfoRecord()...)
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write record: %w", err)
+		}
 	}
-	fmt.Println(strings.Repeat("=", 120))
+
+	fmt.Printf("Stream results saved to: %s\n", filepath)
+	return nil
 }
 
-// PrintSymmetryResults prints symmetry test results to console
-func (r *Reporter) PrintSymmetryResults(results []serializers.SymmetryResult) {
-	fmt.Println("\n" + strings.Repeat("=", 100))
-	fmt.Println("STRICT TYPE PRESERVATION TEST RESULTS")
-	fmt.Println(strings.Repeat("=", 100))
+// SaveReuseResults saves buffer reuse benchmark results to CSV
+func (r *Reporter) SaveReuseResults(results []serializers.ReuseResult) error {
+	filename := fmt.Sprintf("reuse_results_%s.csv", time.Now().Format("20060102_150405"))
+	filepath := filepath.Join(r.outputDir, filename)
 
-	fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s\n",
-		"Serializer", "Empty→Empty", "Empty{}→{}", "Nil→Nil", "Nil→Nil")
-	fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s\n",
-		"", "(Slices)", "(Maps)", "(Slices)", "(Maps)")
-	fmt.Println(strings.Repeat("-", 100))
+	file, err := os.Create(filepath)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	// Write header
+	header := []string{
+		"Serializer", "RecordCount", "AppendMarshal", "UnmarshalInto",
+		"MarshalNsPerOp", "AppendNsPerOp", "UnmarshalNsPerOp", "IntoNsPerOp",
+	}
+	header = append(header, allocStatsHeader("Marshal")...)
+	header = append(header, allocStatsHeader("Append")...)
+	header = append(header, allocStatsHeader("Unmarshal")...)
+	header = append(header, allocStatsHeader("Into")...)
+	header = append(header, r.runInfoHeader()...)
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write header: %w", err)
+	}
 
+	// Write data
 	for _, result := range results {
-		fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s\n",
+		record := []string{
 			result.SerializerName,
-			boolToString(result.StrictEmptySlicesOK),
-			boolToString(result.StrictEmptyMapsOK),
-			boolToString(result.StrictNilSlicesOK),
-			boolToString(result.StrictNilMapsOK))
+			strconv.Itoa(result.RecordCount),
+			boolToString(result.AppendMarshal),
+			boolToString(result.UnmarshalInto),
+			strconv.FormatInt(result.MarshalNsPerOp, 10),
+			strconv.FormatInt(result.AppendNsPerOp, 10),
+			strconv.FormatInt(result.UnmarshalNsPerOp, 10),
+			strconv.FormatInt(result.IntoNsPerOp, 10),
+		}
+		record = append(record, allocStatsRecord(result.MarshalAllocAvg)...)
+		record = append(record, allocStatsRecord(result.AppendAllocAvg)...)
+		record = append(record, allocStatsRecord(result.UnmarshalAllocAvg)...)
+		record = append(record, allocStatsRecord(result.IntoAllocAvg)...)
+		record = append(record, r.runInfoRecord()...)
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write record: %w", err)
+		}
 	}
 
-	fmt.Println(strings.Repeat("=", 100))
+	fmt.Printf("Reuse results saved to: %s\n", filepath)
+	return nil
+}
 
-	// Print details
-	fmt.Println("\nDetails:")
-	for _, result := range results {
-		fmt.Printf("%-12s: %s\n", result.SerializerName, result.Details)
+// SavePartialResults saves partial read benchmark results to CSV
+func (r *Reporter) SavePartialResults(results []serializers.PartialResult) error {
+	filename := fmt.Sprintf("partial_results_%s.csv", time.Now().Format("20060102_150405"))
+	filepath := filepath.Join(r.outputDir, filename)
+
+	file, err := os.Create(filepath)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
 	}
-}
+	defer file.Close()
 
-// PrintRedisResults prints Redis benchmark results to console
-func (r *Reporter) PrintRedisResults(results []redis.RedisResult) {
-	fmt.Println("\n" + strings.Repeat("=", 100))
-	fmt.Println("REDIS PERFORMANCE RESULTS")
-	fmt.Println(strings.Repeat("=", 100))
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
 
-	// First table: Total time (including serialization)
-	fmt.Println("Total Time (including serialization):")
-	fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s\n",
-		"Serializer", "SET Avg", "SET Med", "GET Avg", "GET Med")
-	fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s\n",
-		"", "(ms)", "(ms)", "(ms)", "(ms)")
-	fmt.Println(strings.Repeat("-", 100))
+	// Write header
+	header := []string{"Serializer", "RecordCount", "Fields", "UnmarshalNsPerOp", "ReadNsPerOp"}
+	header = append(header, allocStatsHeader("Unmarshal")...)
+	header = append(header, allocStatsHeader("Read")...)
+	header = append(header, r.runInfoHeader()...)
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write header: %w", err)
+	}
 
+	// Write data
 	for _, result := range results {
-		fmt.Printf("%-12s | %-12.2f | %-12.2f | %-12.2f | %-12.2f\n",
+		record := []string{
 			result.SerializerName,
-			float64(result.TotalSetAvgNs)/1000000.0,
-			float64(result.TotalSetMedianNs)/1000000.0,
-			float64(result.TotalGetAvgNs)/1000000.0,
-			float64(result.TotalGetMedianNs)/1000000.0)
+			strconv.Itoa(result.RecordCount),
+			strings.Join(result.Fields, ","),
+			strconv.FormatInt(result.UnmarshalNsPerOp, 10),
+			strconv.FormatInt(result.ReadNsPerOp, 10),
+		}
+		record = append(record, allocStatsRecord(result.UnmarshalAllocAvg)...)
+		record = append(record, allocStatsRecord(result.ReadAllocAvg)...)
+		record = append(record, r.runInfoRecord()...)
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write record: %w", err)
+		}
 	}
 
-	fmt.Println()
-	fmt.Println("Pure I/O Time (Redis operations only):")
-	fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s\n",
-		"Serializer", "SET Avg", "SET Med", "GET Avg", "GET Med")
-	fmt.Printf("%-12s | %-12s | %-12s | %-12s | %-12s\n",
-		"", "(ms)", "(ms)", "(ms)", "(ms)")
-	fmt.Println(strings.Repeat("-", 100))
+	fmt.Printf("Partial read results saved to: %s\n", filepath)
+	return nil
+}
+
+// SaveConcurrencyResults saves concurrency benchmark results to CSV
+func (r *Reporter) SaveConcurrencyResults(results []serializers.ConcurrencyResult) error {
+	filename := fmt.Sprintf("concurrency_results_%s.csv", time.Now().Format("20060102_150405"))
+	filepath := filepath.Join(r.outputDir, filename)
+
+	file, err := os.Create(filepath)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
 
+	// Write header
+	header := []string{"Serializer", "Goroutines", "Duration_ns", "MarshalOps", "UnmarshalOps",
+		"MarshalOpsPerSec", "UnmarshalOpsPerSec", "MarshalEfficiency", "UnmarshalEfficiency"}
+	header = append(header, latencyHeader("Marshal")...)
+	header = append(header, latencyHeader("Unmarshal")...)
+	header = append(header, r.runInfoHeader()...)
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write header: %w", err)
+	}
+
+	// Write data
 	for _, result := range results {
-		fmt.Printf("%-12s | %-12.2f | %-12.2f | %-12.2f | %-12.2f\n",
+		record := []string{
 			result.SerializerName,
-			float64(result.SetAvgNs)/1000000.0,
-			float64(result.SetMedianNs)/1000000.0,
-			float64(result.GetAvgNs)/1000000.0,
-			float64(result.GetMedianNs)/1000000.0)
+			strconv.Itoa(result.Workers),
+			strconv.FormatInt(result.DurationNs, 10),
+			strconv.FormatInt(result.MarshalOps, 10),
+			strconv.FormatInt(result.UnmarshalOps, 10),
+			fmt.Sprintf("%.2f", result.MarshalOpsPerSec),
+			fmt.Sprintf("%.2f", result.UnmarshalOpsPerSec),
+			fmt.Sprintf("%.4f", result.MarshalEfficiency),
+			fmt.Sprintf("%.4f", result.UnmarshalEfficiency),
+		}
+		record = append(record, latencyRecord(result.MarshalLatency)...)
+		record = append(record, latencyRecord(result.UnmarshalLatency)...)
+		record = append(record, r.runInfoRecord()...)
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write record: %w", err)
+		}
 	}
-	fmt.Println(strings.Repeat("=", 100))
+
+	fmt.Printf("Concurrency results saved to: %s\n", filepath)
+	return nil
 }
 
-// SaveSerializationResults saves serialization results to CSV
-func (r *Reporter) SaveSerializationResults(results []serializers.SerializationResult) error {
-	filename := fmt.Sprintf("serialization_results_%s.csv", time.Now().Format("20060102_150405"))
+// SavePerRecordResults saves per-record benchmark results to CSV
+func (r *Reporter) SavePerRecordResults(results []serializers.PerRecordResult) error {
+	filename := fmt.Sprintf("per_record_results_%s.csv", time.Now().Format("20060102_150405"))
 	filepath := filepath.Join(r.outputDir, filename)
 
 	file, err := os.Create(filepath)
@@ -138,10 +1211,13 @@ func (r *Reporter) SaveSerializationResults(results []serializers.SerializationR
 
 	// Write header
 	header := []string{
-		"Serializer", "DataSize_Bytes", "DataSize_MB", "MarshalAvg_ns", "MarshalMedian_ns",
-		"UnmarshalAvg_ns", "UnmarshalMedian_ns", "MarshalAvg_ms", "MarshalMedian_ms",
-		"UnmarshalAvg_ms", "UnmarshalMedian_ms",
+		"Serializer", "RecordCount", "MarshalNsPerOp", "UnmarshalNsPerOp",
+		"MarshalRecordsPerSec", "UnmarshalRecordsPerSec",
+		"SizeMin_Bytes", "SizeAvg_Bytes", "SizeP50_Bytes", "SizeP99_Bytes", "SizeMax_Bytes",
 	}
+	header = append(header, allocStatsHeader("Marshal")...)
+	header = append(header, allocStatsHeader("Unmarshal")...)
+	header = append(header, r.runInfoHeader()...)
 	if err := writer.Write(header); err != nil {
 		return fmt.Errorf("failed to write header: %w", err)
 	}
@@ -150,23 +1226,167 @@ func (r *Reporter) SaveSerializationResults(results []serializers.SerializationR
 	for _, result := range results {
 		record := []string{
 			result.SerializerName,
-			strconv.Itoa(result.DataSize),
-			fmt.Sprintf("%.2f", float64(result.DataSize)/1000000.0),
-			strconv.FormatInt(result.MarshalAvgNs, 10),
-			strconv.FormatInt(result.MarshalMedianNs, 10),
-			strconv.FormatInt(result.UnmarshalAvgNs, 10),
-			strconv.FormatInt(result.UnmarshalMedianNs, 10),
-			fmt.Sprintf("%.2f", float64(result.MarshalAvgNs)/1000000.0),
-			fmt.Sprintf("%.2f", float64(result.MarshalMedianNs)/1000000.0),
-			fmt.Sprintf("%.2f", float64(result.UnmarshalAvgNs)/1000000.0),
-			fmt.Sprintf("%.2f", float64(result.UnmarshalMedianNs)/1000000.0),
+			strconv.Itoa(result.RecordCount),
+			strconv.FormatInt(result.MarshalNsPerOp, 10),
+			strconv.FormatInt(result.UnmarshalNsPerOp, 10),
+			fmt.Sprintf("%.2f", result.MarshalRecordsPerSec),
+			fmt.Sprintf("%.2f", result.UnmarshalRecordsPerSec),
+			strconv.Itoa(result.SizeMin),
+			strconv.Itoa(result.SizeAvg),
+			strconv.Itoa(result.SizeP50),
+			strconv.Itoa(result.SizeP99),
+			strconv.Itoa(result.SizeMax),
 		}
+		record = append(record, allocStatsRecord(result.MarshalAllocAvg)...)
+		record = append(record, allocStatsRecord(result.UnmarshalAllocAvg)...)
+		record = append(record, r.runInfoRecord()...)
 		if err := writer.Write(record); err != nil {
 			return fmt.Errorf("failed to write record: %w", err)
 		}
 	}
 
-	fmt.Printf("Serialization results saved to: %s\n", filepath)
+	fmt.Printf("Per-record results saved to: %s\n", filepath)
+	return nil
+}
+
+// SaveFidelityResults saves field-level round-trip fidelity results to CSV, one row per
+// serializer and field path
+func (r *Reporter) SaveFidelityResults(results []serializers.FidelityResult) error {
+	filename := fmt.Sprintf("fidelity_results_%s.csv", time.Now().Format("20060102_150405"))
+	filepath := filepath.Join(r.outputDir, filename)
+
+	file, err := os.Create(filepath)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	// Write header
+	header := []string{"Serializer", "Users", "Errors", "Path", "Compared"}
+	for _, aspect := range fidelity.Aspects {
+		header = append(header, "Lost_"+string(aspect))
+	}
+	header = append(header, "Example")
+	header = append(header, r.runInfoHeader()...)
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write header: %w", err)
+	}
+
+	// Write data
+	for _, result := range results {
+		for _, f := range result.Fields {
+			record := []string{
+				result.SerializerName,
+				strconv.Itoa(result.Users),
+				strconv.Itoa(result.Errors),
+				f.Path,
+				strconv.Itoa(f.Compared),
+			}
+			for _, aspect := range fidelity.Aspects {
+				record = append(record, strconv.Itoa(f.Lost[aspect]))
+			}
+			record = append(record, f.Example)
+			record = append(record, r.runInfoRecord()...)
+			if err := writer.Write(record); err != nil {
+				return fmt.Errorf("failed to write record: %w", err)
+			}
+		}
+	}
+
+	fmt.Printf("Fidelity results saved to: %s\n", filepath)
+	return nil
+}
+
+// SaveMetadataTypeResults saves Metadata type fidelity results to CSV, one row per
+// serializer and value kind
+func (r *Reporter) SaveMetadataTypeResults(results []serializers.MetadataTypeResult) error {
+	filename := fmt.Sprintf("metadata_type_results_%s.csv", time.Now().Format("20060102_150405"))
+	filepath := filepath.Join(r.outputDir, filename)
+
+	file, err := os.Create(filepath)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	// Write header
+	header := []string{"Serializer", "Kind", "OriginalType", "RestoredType", "Outcome", "Detail"}
+	header = append(header, r.runInfoHeader()...)
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write header: %w", err)
+	}
+
+	// Write data
+	for _, result := range results {
+		for _, check := range result.Checks {
+			record := []string{
+				result.SerializerName,
+				check.Kind,
+				check.OriginalType,
+				check.RestoredType,
+				check.Outcome,
+				check.Detail,
+			}
+			record = append(record, r.runInfoRecord()...)
+			if err := writer.Write(record); err != nil {
+				return fmt.Errorf("failed to write record: %w", err)
+			}
+		}
+	}
+
+	fmt.Printf("Metadata type results saved to: %s\n", filepath)
+	return nil
+}
+
+// SaveCorruptionResults saves corruption robustness results to CSV, one row per serializer and
+// kind of damage
+func (r *Reporter) SaveCorruptionResults(results []serializers.CorruptionResult) error {
+	filename := fmt.Sprintf("corruption_results_%s.csv", time.Now().Format("20060102_150405"))
+	filepath := filepath.Join(r.outputDir, filename)
+
+	file, err := os.Create(filepath)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	// Write header
+	header := []string{"Serializer", "Damage", "Trials", "Errors", "Panics", "Wrong", "Correct", "Wrong_Percent"}
+	header = append(header, r.runInfoHeader()...)
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write header: %w", err)
+	}
+
+	// Write data
+	for _, result := range results {
+		for _, counts := range result.Damages {
+			record := []string{
+				result.SerializerName,
+				counts.Damage,
+				strconv.Itoa(counts.Trials),
+				strconv.Itoa(counts.Errors),
+				strconv.Itoa(counts.Panics),
+				strconv.Itoa(counts.Wrong),
+				strconv.Itoa(counts.Correct),
+				fmt.Sprintf("%.2f", percentOf(counts.Wrong, counts.Trials)),
+			}
+			record = append(record, r.runInfoRecord()...)
+			if err := writer.Write(record); err != nil {
+				return fmt.Errorf("failed to write record: %w", err)
+			}
+		}
+	}
+
+	fmt.Printf("Corruption results saved to: %s\n", filepath)
 	return nil
 }
 
@@ -186,8 +1406,12 @@ func (r *Reporter) SaveSymmetryResults(results []serializers.SymmetryResult) err
 
 	// Write header
 	header := []string{
-		"Serializer", "StrictEmptySlicesOK", "StrictEmptyMapsOK", "StrictNilSlicesOK", "StrictNilMapsOK", "Details",
+		"Serializer", "StrictEmptySlicesOK", "StrictEmptyMapsOK", "StrictNilSlicesOK", "StrictNilMapsOK",
+		"TimeNanosecondsOK", "TimeLocationOK", "TimeZeroOK", "TimeRangeOK", "TimeEqualOK", "TimeIdenticalOK",
+		"NumericInt64", "NumericInt32", "NumericFloat53",
+		"StringMultiByte", "StringEmojiZWJ", "StringInvalidUTF8", "StringNUL", "StringHTML", "StringLong", "Details",
 	}
+	header = append(header, r.runInfoHeader()...)
 	if err := writer.Write(header); err != nil {
 		return fmt.Errorf("failed to write header: %w", err)
 	}
@@ -200,8 +1424,24 @@ func (r *Reporter) SaveSymmetryResults(results []serializers.SymmetryResult) err
 			boolToString(result.StrictEmptyMapsOK),
 			boolToString(result.StrictNilSlicesOK),
 			boolToString(result.StrictNilMapsOK),
+			boolToString(result.TimeNanosecondsOK),
+			boolToString(result.TimeLocationOK),
+			boolToString(result.TimeZeroOK),
+			boolToString(result.TimeRangeOK),
+			boolToString(result.TimeEqualOK),
+			boolToString(result.TimeIdenticalOK),
+			result.NumericInt64,
+			result.NumericInt32,
+			result.NumericFloat53,
+			result.StringMultiByte,
+			result.StringEmojiZWJ,
+			result.StringInvalidUTF8,
+			result.StringNUL,
+			result.StringHTML,
+			result.StringLong,
 			result.Details,
 		}
+		record = append(record, r.runInfoRecord()...)
 		if err := writer.Write(record); err != nil {
 			return fmt.Errorf("failed to write record: %w", err)
 		}
@@ -227,12 +1467,15 @@ func (r *Reporter) SaveRedisResults(results []redis.RedisResult) error {
 
 	// Write header
 	header := []string{
-		"Serializer",
+		"Serializer", "DataSize_Bytes",
 		"TotalSetAvg_ns", "TotalSetMedian_ns", "TotalGetAvg_ns", "TotalGetMedian_ns",
 		"TotalSetAvg_ms", "TotalSetMedian_ms", "TotalGetAvg_ms", "TotalGetMedian_ms",
 		"IOSetAvg_ns", "IOSetMedian_ns", "IOGetAvg_ns", "IOGetMedian_ns",
 		"IOSetAvg_ms", "IOSetMedian_ms", "IOGetAvg_ms", "IOGetMedian_ms",
 	}
+	header = append(header, allocStatsHeader("TotalSet")...)
+	header = append(header, allocStatsHeader("TotalGet")...)
+	header = append(header, r.runInfoHeader()...)
 	if err := writer.Write(header); err != nil {
 		return fmt.Errorf("failed to write header: %w", err)
 	}
@@ -241,6 +1484,7 @@ func (r *Reporter) SaveRedisResults(results []redis.RedisResult) error {
 	for _, result := range results {
 		record := []string{
 			result.SerializerName,
+			strconv.Itoa(result.DataSize),
 			// Total times (including serialization)
 			strconv.FormatInt(result.TotalSetAvgNs, 10),
 			strconv.FormatInt(result.TotalSetMedianNs, 10),
@@ -260,6 +1504,10 @@ func (r *Reporter) SaveRedisResults(results []redis.RedisResult) error {
 			fmt.Sprintf("%.2f", float64(result.GetAvgNs)/1000000.0),
 			fmt.Sprintf("%.2f", float64(result.GetMedianNs)/1000000.0),
 		}
+		// Allocation and GC activity (including serialization)
+		record = append(record, allocStatsRecord(result.TotalSetAllocAvg)...)
+		record = append(record, allocStatsRecord(result.TotalGetAllocAvg)...)
+		record = append(record, r.runInfoRecord()...)
 		if err := writer.Write(record); err != nil {
 			return fmt.Errorf("failed to write record: %w", err)
 		}
@@ -274,6 +1522,64 @@ func (r *Reporter) EnsureOutputDir() error {
 	return os.MkdirAll(r.outputDir, 0755)
 }
 
+// allocStatsHeader returns the CSV header columns for allocation statistics of an operation
+func allocStatsHeader(op string) []string {
+	return []string{
+		op + "AllocBytes", op + "Allocs", op + "GCCycles", op + "GCPause_ns",
+	}
+}
+
+// allocStatsRecord returns the CSV record columns for allocation statistics
+func allocStatsRecord(a utils.AllocStats) []string {
+	return []string{
+		strconv.FormatInt(a.Bytes, 10),
+		strconv.FormatInt(a.Allocs, 10),
+		strconv.FormatInt(a.GCCycles, 10),
+		strconv.FormatInt(a.GCPauseNs, 10),
+	}
+}
+
+// latencyHeader returns the CSV header columns for latency percentiles of an operation
+func latencyHeader(op string) []string {
+	return []string{op + "P50_ns", op + "P90_ns", op + "P99_ns", op + "Max_ns"}
+}
+
+// latencyRecord returns the CSV record columns for latency percentiles
+func latencyRecord(l utils.LatencyPercentiles) []string {
+	return []string{
+		strconv.FormatInt(l.P50, 10),
+		strconv.FormatInt(l.P90, 10),
+		strconv.FormatInt(l.P99, 10),
+		strconv.FormatInt(l.Max, 10),
+	}
+}
+
+// summaryHeader returns the CSV header columns for timing statistics of an operation
+func summaryHeader(op string) []string {
+	return []string{
+		op + "StdDev_ns", op + "Min_ns", op + "Max_ns", op + "P90_ns", op + "P95_ns", op + "P99_ns",
+		op + "CV", op + "Outliers",
+	}
+}
+
+// summaryRecord returns the CSV record columns for timing statistics
+func summaryRecord(s utils.Summary) []string {
+	outliers := make([]string, len(s.Outliers))
+	for i, idx := range s.Outliers {
+		outliers[i] = strconv.Itoa(idx + 1) // 1-based iteration numbers
+	}
+	return []string{
+		fmt.Sprintf("%.0f", s.StdDev),
+		strconv.FormatInt(s.Min, 10),
+		strconv.FormatInt(s.Max, 10),
+		strconv.FormatInt(s.P90, 10),
+		strconv.FormatInt(s.P95, 10),
+		strconv.FormatInt(s.P99, 10),
+		fmt.Sprintf("%.4f", s.CV),
+		strings.Join(outliers, ";"),
+	}
+}
+
 // boolToString converts boolean to string representation
 func boolToString(b bool) string {
 	if b {
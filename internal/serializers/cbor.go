@@ -1,6 +1,9 @@
 package serializers
 
 import (
+	"bytes"
+	"io"
+
 	"github.com/fxamacker/cbor/v2"
 	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/models"
 )
@@ -30,6 +33,21 @@ func (c *CBORSerializer) Unmarshal(data []byte) (models.User, error) {
 	return user, err
 }
 
+// AppendMarshal appends the CBOR encoding of a User to dst
+func (c *CBORSerializer) AppendMarshal(dst []byte, user models.User) ([]byte, error) {
+	buf := bytes.NewBuffer(dst)
+	if err := cbor.MarshalToBuffer(user, buf); err != nil {
+		return dst, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalInto deserializes CBOR bytes into an existing User
+func (c *CBORSerializer) UnmarshalInto(data []byte, user *models.User) error {
+	clearMaps(user)
+	return cbor.Unmarshal(data, user)
+}
+
 // MarshalUsers serializes a collection of Users to CBOR bytes
 func (c *CBORSerializer) MarshalUsers(users models.Users) ([]byte, error) {
 	return cbor.Marshal(users)
@@ -41,3 +59,13 @@ func (c *CBORSerializer) UnmarshalUsers(data []byte) (models.Users, error) {
 	err := cbor.Unmarshal(data, &users)
 	return users, err
 }
+
+// NewStreamEncoder creates an encoder writing a sequence of CBOR users
+func (c *CBORSerializer) NewStreamEncoder(w io.Writer) StreamEncoder {
+	return valueEncoder{cbor.NewEncoder(w)}
+}
+
+// NewStreamDecoder creates a decoder reading a sequence of CBOR users
+func (c *CBORSerializer) NewStreamDecoder(r io.Reader) StreamDecoder {
+	return valueDecoder{cbor.NewDecoder(r)}
+}
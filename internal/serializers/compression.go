@@ -0,0 +1,253 @@
+package serializers
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/models"
+)
+
+// DefaultCompressionLevel selects the default level of a codec
+const DefaultCompressionLevel = -1
+
+// Codec compresses and decompresses serialized data at a fixed level
+type Codec interface {
+	Name() string
+	Compress(data []byte) ([]byte, error)
+	Decompress(data []byte) ([]byte, error)
+}
+
+// CodecFactory creates a codec for a compression level (DefaultCompressionLevel for the default)
+type CodecFactory func(level int) (Codec, error)
+
+// codecRegistration is a registered codec factory
+type codecRegistration struct {
+	name    string
+	factory CodecFactory
+}
+
+// codecs contains all registered codecs in registration order
+var codecs = []codecRegistration{
+	{"gzip", newGzipCodec},
+	{"zlib", newZlibCodec},
+	{"flate", newFlateCodec},
+}
+
+// RegisterCodec adds a compression codec, making "<Serializer>+<name>" serializers available.
+// Codecs that need third-party modules (zstd, snappy, lz4, ...) register themselves this way.
+func RegisterCodec(name string, factory CodecFactory) error {
+	if strings.ContainsAny(name, "+:") {
+		return fmt.Errorf("invalid codec name %q", name)
+	}
+	if _, ok := lookupCodec(name); ok {
+		return fmt.Errorf("codec %q is already registered", name)
+	}
+	codecs = append(codecs, codecRegistration{name, factory})
+	return nil
+}
+
+// CodecNames returns the names of all registered codecs
+func CodecNames() []string {
+	names := make([]string, len(codecs))
+	for i, c := range codecs {
+		names[i] = c.name
+	}
+	return names
+}
+
+// NewCodec creates a codec from a "<name>" or "<name>:<level>" spec
+func NewCodec(spec string) (Codec, error) {
+	name, levelText, hasLevel := strings.Cut(spec, ":")
+	c, ok := lookupCodec(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown codec %q (registered codecs: %s)", name, strings.Join(CodecNames(), ", "))
+	}
+
+	level := DefaultCompressionLevel
+	if hasLevel {
+		var err error
+		if level, err = strconv.Atoi(levelText); err != nil {
+			return nil, fmt.Errorf("invalid level %q for codec %s", levelText, c.name)
+		}
+	}
+	return c.factory(level)
+}
+
+// lookupCodec returns the registered codec with the given name (case-insensitive)
+func lookupCodec(name string) (codecRegistration, bool) {
+	for _, c := range codecs {
+		if strings.EqualFold(c.name, name) {
+			return c, true
+		}
+	}
+	return codecRegistration{}, false
+}
+
+// CompressedSerializer implements Serializer interface by compressing the output of another serializer
+type CompressedSerializer struct {
+	inner Serializer
+	codec Codec
+}
+
+// NewCompressedSerializer creates a new CompressedSerializer
+func NewCompressedSerializer(inner Serializer, codec Codec) *CompressedSerializer {
+	return &CompressedSerializer{inner: inner, codec: codec}
+}
+
+// Name returns the name of the serializer
+func (c *CompressedSerializer) Name() string {
+	return c.inner.Name() + "+" + c.codec.Name()
+}
+
+// Inner returns the wrapped serializer
+func (c *CompressedSerializer) Inner() Serializer {
+	return c.inner
+}
+
+// Marshal serializes a User and compresses the result
+func (c *CompressedSerializer) Marshal(user models.User) ([]byte, error) {
+	data, err := c.inner.Marshal(user)
+	if err != nil {
+		return nil, err
+	}
+	return c.codec.Compress(data)
+}
+
+// Unmarshal decompresses and deserializes bytes to a User
+func (c *CompressedSerializer) Unmarshal(data []byte) (models.User, error) {
+	raw, err := c.codec.Decompress(data)
+	if err != nil {
+		return models.User{}, err
+	}
+	return c.inner.Unmarshal(raw)
+}
+
+// MarshalUsers serializes Users and compresses the result
+func (c *CompressedSerializer) MarshalUsers(users models.Users) ([]byte, error) {
+	data, err := c.inner.MarshalUsers(users)
+	if err != nil {
+		return nil, err
+	}
+	return c.codec.Compress(data)
+}
+
+// UnmarshalUsers decompresses and deserializes bytes to Users
+func (c *CompressedSerializer) UnmarshalUsers(data []byte) (models.Users, error) {
+	raw, err := c.codec.Decompress(data)
+	if err != nil {
+		return nil, err
+	}
+	return c.inner.UnmarshalUsers(raw)
+}
+
+// streamCodec implements Codec for stdlib compress/* formats, reusing writers and readers
+type streamCodec struct {
+	name    string
+	writers sync.Pool
+	readers sync.Pool
+	reader  func(r io.Reader, reuse io.ReadCloser) (io.ReadCloser, error)
+}
+
+// streamWriter is the writer side of a stdlib compression format
+type streamWriter interface {
+	io.WriteCloser
+	Reset(w io.Writer)
+}
+
+// newStreamCodec creates a streamCodec. newWriter is called once up front to validate level.
+func newStreamCodec(name string, level int, newWriter func(level int) (streamWriter, error),
+	reader func(r io.Reader, reuse io.ReadCloser) (io.ReadCloser, error)) (Codec, error) {
+	if _, err := newWriter(level); err != nil {
+		return nil, fmt.Errorf("codec %s: %w", name, err)
+	}
+	if level != DefaultCompressionLevel {
+		name = fmt.Sprintf("%s:%d", name, level)
+	}
+
+	c := &streamCodec{name: name, reader: reader}
+	c.writers.New = func() any {
+		w, _ := newWriter(level)
+		return w
+	}
+	return c, nil
+}
+
+// Name returns the name of the codec including a non-default level
+func (c *streamCodec) Name() string {
+	return c.name
+}
+
+// Compress compresses data
+func (c *streamCodec) Compress(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := c.writers.Get().(streamWriter)
+	defer c.writers.Put(w)
+
+	w.Reset(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Decompress decompresses data
+func (c *streamCodec) Decompress(data []byte) ([]byte, error) {
+	reuse, _ := c.readers.Get().(io.ReadCloser)
+	r, err := c.reader(bytes.NewReader(data), reuse)
+	if err != nil {
+		return nil, err
+	}
+	defer c.readers.Put(r)
+
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return raw, r.Close()
+}
+
+// newGzipCodec creates a gzip codec
+func newGzipCodec(level int) (Codec, error) {
+	return newStreamCodec("gzip", level,
+		func(level int) (streamWriter, error) { return gzip.NewWriterLevel(nil, level) },
+		func(r io.Reader, reuse io.ReadCloser) (io.ReadCloser, error) {
+			if zr, ok := reuse.(*gzip.Reader); ok {
+				return zr, zr.Reset(r)
+			}
+			return gzip.NewReader(r)
+		})
+}
+
+// newZlibCodec creates a zlib codec
+func newZlibCodec(level int) (Codec, error) {
+	return newStreamCodec("zlib", level,
+		func(level int) (streamWriter, error) { return zlib.NewWriterLevel(nil, level) },
+		func(r io.Reader, reuse io.ReadCloser) (io.ReadCloser, error) {
+			if reuse != nil {
+				return reuse, reuse.(zlib.Resetter).Reset(r, nil)
+			}
+			return zlib.NewReader(r)
+		})
+}
+
+// newFlateCodec creates a raw DEFLATE codec
+func newFlateCodec(level int) (Codec, error) {
+	return newStreamCodec("flate", level,
+		func(level int) (streamWriter, error) { return flate.NewWriter(nil, level) },
+		func(r io.Reader, reuse io.ReadCloser) (io.ReadCloser, error) {
+			if reuse != nil {
+				return reuse, reuse.(flate.Resetter).Reset(r, nil)
+			}
+			return flate.NewReader(r), nil
+		})
+}
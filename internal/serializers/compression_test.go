@@ -0,0 +1,116 @@
+package serializers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/models"
+)
+
+func TestNewCodec(t *testing.T) {
+	tests := []struct {
+		spec string
+		name string // codec name, empty if the spec is rejected
+	}{
+		{"gzip", "gzip"},
+		{"GZip", "gzip"},
+		{"zlib", "zlib"},
+		{"flate", "flate"},
+		{"gzip:9", "gzip:9"},
+		{"zlib:1", "zlib:1"},
+		{"flate:0", "flate:0"},
+		{"gzip:-1", "gzip"}, // DefaultCompressionLevel
+		{"gzip:10", ""},
+		{"flate:-3", ""},
+		{"gzip:", ""},
+		{"gzip:fast", ""},
+		{"zstd", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		codec, err := NewCodec(tt.spec)
+		switch {
+		case tt.name == "" && err == nil:
+			t.Errorf("NewCodec(%q) = %s, want an error", tt.spec, codec.Name())
+		case tt.name != "" && err != nil:
+			t.Errorf("NewCodec(%q) failed: %v", tt.spec, err)
+		case tt.name != "" && codec.Name() != tt.name:
+			t.Errorf("NewCodec(%q).Name() = %q, want %q", tt.spec, codec.Name(), tt.name)
+		}
+	}
+}
+
+// TestCompressedSerializerRoundTrip round-trips users through a CompressedSerializer for every
+// registered codec at its default, fastest and best level
+func TestCompressedSerializerRoundTrip(t *testing.T) {
+	users := models.GenerateTestUsers(8, 1, models.ProfileTypical)
+	inner := NewJSONSerializer()
+	want, err := inner.UnmarshalUsers(mustMarshalUsers(t, inner, users))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range CodecNames() {
+		for _, spec := range []string{name, name + ":1", name + ":9"} {
+			t.Run(spec, func(t *testing.T) {
+				codec, err := NewCodec(spec)
+				if err != nil {
+					t.Fatalf("NewCodec failed: %v", err)
+				}
+				ser := NewCompressedSerializer(inner, codec)
+				if got := ser.Name(); got != "JSON+"+codec.Name() {
+					t.Errorf("Name() = %q, want %q", got, "JSON+"+codec.Name())
+				}
+
+				raw := mustMarshalUsers(t, inner, users)
+				data := mustMarshalUsers(t, ser, users)
+				if len(data) >= len(raw) {
+					t.Errorf("compressed size %d is not below the raw size %d", len(data), len(raw))
+				}
+				decompressed, err := codec.Decompress(data)
+				if err != nil {
+					t.Fatalf("Decompress failed: %v", err)
+				}
+				if got, err := inner.UnmarshalUsers(decompressed); err != nil || !reflect.DeepEqual(got, want) {
+					t.Errorf("Decompress did not restore a JSON encoding of the users (err: %v)", err)
+				}
+				got, err := ser.UnmarshalUsers(data)
+				if err != nil {
+					t.Fatalf("UnmarshalUsers failed: %v", err)
+				}
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("UnmarshalUsers result differs from the uncompressed round trip")
+				}
+
+				// Reuse pooled writers and readers across calls
+				for i, user := range users {
+					data, err := ser.Marshal(user)
+					if err != nil {
+						t.Fatalf("Marshal of user %d failed: %v", user.ID, err)
+					}
+					got, err := ser.Unmarshal(data)
+					if err != nil {
+						t.Fatalf("Unmarshal of user %d failed: %v", user.ID, err)
+					}
+					if !reflect.DeepEqual(got, want[i]) {
+						t.Errorf("user %d differs from the uncompressed round trip", user.ID)
+					}
+				}
+
+				if _, err := ser.Unmarshal([]byte("not compressed")); err == nil {
+					t.Errorf("Unmarshal of uncompressed data succeeded, want an error")
+				}
+			})
+		}
+	}
+}
+
+// mustMarshalUsers marshals users with ser, failing the test on error
+func mustMarshalUsers(t *testing.T, ser Serializer, users models.Users) []byte {
+	t.Helper()
+	data, err := ser.MarshalUsers(users)
+	if err != nil {
+		t.Fatalf("%s: MarshalUsers failed: %v", ser.Name(), err)
+	}
+	return data
+}
@@ -0,0 +1,193 @@
+package serializers
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/models"
+)
+
+// The concurrency tests share one serializer instance between goroutines, as the benchmarks
+// and production code do. Run them with the race detector:
+//
+//	go test -race -run Concurrent ./internal/serializers
+const (
+	concurrentGoroutines = 8
+	concurrentUsers      = 16 // distinct users per goroutine
+	concurrentRounds     = 3
+)
+
+// roundTrip holds the sequentially decoded users a concurrent round trip must reproduce
+type roundTrip struct {
+	users models.Users // Unmarshal(Marshal(user)) for every user
+	slice models.Users // UnmarshalUsers(MarshalUsers(chunk)) for the chunk of every goroutine
+}
+
+// TestConcurrentRoundTrip marshals and unmarshals distinct users from several goroutines with a
+// shared instance of every registered serializer and compressed variant, checking each result
+// against a sequential round trip
+func TestConcurrentRoundTrip(t *testing.T) {
+	users := models.GenerateTestUsers(concurrentGoroutines*concurrentUsers, 1, models.ProfileTypical)
+
+	for _, r := range candidates() {
+		t.Run(r.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ser := r.New()
+			want := sequentialRoundTrip(t, ser, users)
+
+			var wg sync.WaitGroup
+			for g := 0; g < concurrentGoroutines; g++ {
+				wg.Add(1)
+				go func(g int) {
+					defer wg.Done()
+					offset := g * concurrentUsers
+					chunk := users[offset : offset+concurrentUsers]
+					for round := 0; round < concurrentRounds; round++ {
+						if err := checkRoundTrip(ser, chunk, want.users[offset:offset+concurrentUsers], want.slice[offset:offset+concurrentUsers]); err != nil {
+							t.Errorf("goroutine %d, round %d: %v", g, round, err)
+							return
+						}
+					}
+				}(g)
+			}
+			wg.Wait()
+		})
+	}
+}
+
+// sequentialRoundTrip decodes the users with a single goroutine to get the expected results
+func sequentialRoundTrip(t *testing.T, ser Serializer, users models.Users) roundTrip {
+	t.Helper()
+
+	want := roundTrip{users: make(models.Users, len(users))}
+	for i, user := range users {
+		data, err := ser.Marshal(user)
+		if err != nil {
+			t.Fatalf("Marshal of user %d: %v", user.ID, err)
+		}
+		if want.users[i], err = ser.Unmarshal(data); err != nil {
+			t.Fatalf("Unmarshal of user %d: %v", user.ID, err)
+		}
+	}
+
+	for offset := 0; offset < len(users); offset += concurrentUsers {
+		data, err := ser.MarshalUsers(users[offset : offset+concurrentUsers])
+		if err != nil {
+			t.Fatalf("MarshalUsers: %v", err)
+		}
+		decoded, err := ser.UnmarshalUsers(data)
+		if err != nil {
+			t.Fatalf("UnmarshalUsers: %v", err)
+		}
+		want.slice = append(want.slice, decoded...)
+	}
+
+	return want
+}
+
+// checkRoundTrip runs every round trip the serializer supports on chunk and compares the
+// results with the sequentially decoded users
+func checkRoundTrip(ser Serializer, chunk, want, wantSlice models.Users) error {
+	for i, user := range chunk {
+		data, err := ser.Marshal(user)
+		if err != nil {
+			return err
+		}
+		got, err := ser.Unmarshal(data)
+		if err != nil {
+			return err
+		}
+		if !reflect.DeepEqual(got, want[i]) {
+			return mismatch("Marshal/Unmarshal", user)
+		}
+	}
+
+	data, err := ser.MarshalUsers(chunk)
+	if err != nil {
+		return err
+	}
+	got, err := ser.UnmarshalUsers(data)
+	if err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(got, wantSlice) {
+		return errors.New("MarshalUsers/UnmarshalUsers result differs from the sequential round trip")
+	}
+
+	if am, ok := ser.(AppendMarshaler); ok {
+		var buf []byte
+		for i, user := range chunk {
+			if buf, err = am.AppendMarshal(buf[:0], user); err != nil {
+				return err
+			}
+			got, err := ser.Unmarshal(buf)
+			if err != nil {
+				return err
+			}
+			if !reflect.DeepEqual(got, want[i]) {
+				return mismatch("AppendMarshal/Unmarshal", user)
+			}
+		}
+	}
+
+	if ui, ok := ser.(IntoUnmarshaler); ok {
+		for i, user := range chunk {
+			data, err := ser.Marshal(user)
+			if err != nil {
+				return err
+			}
+			var got models.User
+			if err := ui.UnmarshalInto(data, &got); err != nil {
+				return err
+			}
+			if !reflect.DeepEqual(got, want[i]) {
+				return mismatch("Marshal/UnmarshalInto", user)
+			}
+		}
+	}
+
+	if ss, ok := ser.(StreamSerializer); ok {
+		return checkStreamRoundTrip(ss, chunk, want)
+	}
+	return nil
+}
+
+// checkStreamRoundTrip encodes chunk to an in-memory stream and decodes it again
+func checkStreamRoundTrip(ss StreamSerializer, chunk, want models.Users) error {
+	var buf bytes.Buffer
+	enc := ss.NewStreamEncoder(&buf)
+	for _, user := range chunk {
+		if err := enc.Encode(user); err != nil {
+			return err
+		}
+	}
+	if err := enc.Flush(); err != nil {
+		return err
+	}
+
+	dec := ss.NewStreamDecoder(&buf)
+	for i, user := range chunk {
+		got, err := dec.Decode()
+		if err != nil {
+			return err
+		}
+		if !reflect.DeepEqual(got, want[i]) {
+			return mismatch("stream Encode/Decode", user)
+		}
+	}
+	if _, err := dec.Decode(); !errors.Is(err, io.EOF) {
+		return errors.New("stream decoder did not return io.EOF after the last user")
+	}
+	return nil
+}
+
+// mismatch reports a round trip whose result differs from the sequential round trip
+func mismatch(op string, user models.User) error {
+	return fmt.Errorf("%s result for user %d differs from the sequential round trip", op, user.ID)
+}
@@ -1,6 +1,10 @@
 package serializers
 
 import (
+	"github.com/mailru/easyjson/buffer"
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+
 	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/models"
 )
 
@@ -29,6 +33,40 @@ func (e *EasyJSONSerializer) Unmarshal(data []byte) (models.User, error) {
 	return user, err
 }
 
+// ReadFields reads fields from JSON bytes without decoding the rest of the user
+func (e *EasyJSONSerializer) ReadFields(data []byte, fields []Field, values []FieldValue) error {
+	return readJSONFields(data, fields, values)
+}
+
+// AppendMarshal appends the JSON encoding of a User to dst using EasyJSON. The writer appends
+// to dst in place. Once dst is full it continues in pooled chunks, and building or dumping
+// those would return dst to easyjson's pool, so the chunks are dropped and the user is encoded
+// again and appended; the grown dst then fits the next user of similar size.
+func (e *EasyJSONSerializer) AppendMarshal(dst []byte, user models.User) ([]byte, error) {
+	w := jwriter.Writer{Buffer: buffer.Buffer{Buf: dst}}
+	user.MarshalEasyJSON(&w)
+	if w.Error != nil {
+		return dst, w.Error
+	}
+	if w.Buffer.Size() == len(w.Buffer.Buf) {
+		return w.Buffer.Buf, nil
+	}
+
+	data, err := user.MarshalJSON()
+	if err != nil {
+		return dst, err
+	}
+	return append(dst, data...), nil
+}
+
+// UnmarshalInto deserializes JSON bytes into an existing User using EasyJSON
+func (e *EasyJSONSerializer) UnmarshalInto(data []byte, user *models.User) error {
+	resetUser(user)
+	l := jlexer.Lexer{Data: data}
+	user.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
 // MarshalUsers serializes a collection of Users to JSON bytes using EasyJSON
 func (e *EasyJSONSerializer) MarshalUsers(users models.Users) ([]byte, error) {
 	return users.MarshalJSON()
@@ -2,6 +2,7 @@ package serializers
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	flatbuffers "github.com/google/flatbuffers/go"
@@ -11,26 +12,61 @@ import (
 )
 
 // FlatBuffersSerializer implements Serializer interface for FlatBuffers
-type FlatBuffersSerializer struct{}
+type FlatBuffersSerializer struct {
+	verify bool // verify buffers before decoding them
+}
+
+// flatBuffersBuilders pools builders for AppendMarshal
+var flatBuffersBuilders = sync.Pool{
+	New: func() any { return flatbuffers.NewBuilder(1024) },
+}
 
 // NewFlatBuffersSerializer creates a new FlatBuffersSerializer
 func NewFlatBuffersSerializer() *FlatBuffersSerializer {
 	return &FlatBuffersSerializer{}
 }
 
+// NewVerifiedFlatBuffersSerializer creates a new FlatBuffersSerializer that verifies every
+// buffer before decoding it, so corrupt input is rejected before the generated accessors read it
+func NewVerifiedFlatBuffersSerializer() *FlatBuffersSerializer {
+	return &FlatBuffersSerializer{verify: true}
+}
+
 // Name returns the name of the serializer
 func (f *FlatBuffersSerializer) Name() string {
+	if f.verify {
+		return "FlatBuffersVerified"
+	}
 	return "FlatBuffers"
 }
 
 // Marshal serializes a User to FlatBuffers bytes
 func (f *FlatBuffersSerializer) Marshal(user models.User) ([]byte, error) {
 	builder := flatbuffers.NewBuilder(1024)
+	if err := f.buildUser(builder, user); err != nil {
+		return nil, err
+	}
+	return builder.FinishedBytes(), nil
+}
 
+// AppendMarshal appends the FlatBuffers encoding of a User to dst using a pooled, reset builder
+func (f *FlatBuffersSerializer) AppendMarshal(dst []byte, user models.User) ([]byte, error) {
+	builder := flatBuffersBuilders.Get().(*flatbuffers.Builder)
+	defer flatBuffersBuilders.Put(builder)
+
+	builder.Reset()
+	if err := f.buildUser(builder, user); err != nil {
+		return dst, err
+	}
+	return append(dst, builder.FinishedBytes()...), nil
+}
+
+// buildUser builds a UserList containing a single user and finishes the builder
+func (f *FlatBuffersSerializer) buildUser(builder *flatbuffers.Builder, user models.User) error {
 	// Create UserList with single user
 	userOffset, err := f.convertUserToFlatBuffer(builder, user)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Create UserList
@@ -43,12 +79,17 @@ func (f *FlatBuffersSerializer) Marshal(user models.User) ([]byte, error) {
 	userList := generated.UserListEnd(builder)
 
 	builder.Finish(userList)
-	return builder.FinishedBytes(), nil
+	return nil
 }
 
 // Unmarshal deserializes FlatBuffers bytes to a User
-func (f *FlatBuffersSerializer) Unmarshal(data []byte) (models.User, error) {
-	userList := generated.GetRootAsUserList(data, 0)
+func (f *FlatBuffersSerializer) Unmarshal(data []byte) (user models.User, err error) {
+	defer recoverCorruptFlatBuffer(f.Name(), &err)
+
+	userList, err := f.rootUserList(data)
+	if err != nil {
+		return models.User{}, err
+	}
 
 	if userList.UsersLength() == 0 {
 		return models.User{}, fmt.Errorf("no users in flatbuffer data")
@@ -62,6 +103,67 @@ func (f *FlatBuffersSerializer) Unmarshal(data []byte) (models.User, error) {
 	return f.convertFlatBufferToUser(fbUser)
 }
 
+// ReadFields reads fields of the first user directly from the FlatBuffers tables, without
+// materializing a models.User. Strings are returned without copying. With verification
+// enabled, the whole buffer is still verified first.
+func (f *FlatBuffersSerializer) ReadFields(data []byte, fields []Field, values []FieldValue) (err error) {
+	defer recoverCorruptFlatBuffer(f.Name(), &err)
+
+	if err := checkFieldValues(fields, values); err != nil {
+		return err
+	}
+	userList, err := f.rootUserList(data)
+	if err != nil {
+		return err
+	}
+	if userList.UsersLength() == 0 {
+		return fmt.Errorf("no users in flatbuffer data")
+	}
+
+	var fbUser generated.User
+	if !userList.Users(&fbUser, 0) {
+		return fmt.Errorf("failed to get user from flatbuffer")
+	}
+	for i, field := range fields {
+		values[i] = flatBufferField(fbUser.Table(), partialFields[field])
+	}
+	return nil
+}
+
+// flatBufferField follows the slots of field from the User table tab and reads its value. An
+// absent table or field has the default value, as with the generated accessors.
+func flatBufferField(tab flatbuffers.Table, field partialField) FieldValue {
+	last := len(field.slots) - 1
+	for _, slot := range field.slots[:last] {
+		o := flatbuffers.UOffsetT(tab.Offset(flatBufferSlot(slot)))
+		if o == 0 {
+			return FieldValue{}
+		}
+		tab.Pos = tab.Indirect(o + tab.Pos)
+	}
+
+	o := flatbuffers.UOffsetT(tab.Offset(flatBufferSlot(field.slots[last])))
+	if o == 0 {
+		return FieldValue{}
+	}
+	pos := o + tab.Pos
+	switch field.kind {
+	case kindInt64:
+		return FieldValue{Int: tab.GetInt64(pos)}
+	case kindInt32:
+		return FieldValue{Int: int64(tab.GetInt32(pos))}
+	case kindBool:
+		return FieldValue{Bool: tab.GetBool(pos)}
+	default:
+		return FieldValue{Bytes: tab.ByteVector(pos)}
+	}
+}
+
+// flatBufferSlot returns the vtable offset of the field with the given index in its table
+func flatBufferSlot(index int) flatbuffers.VOffsetT {
+	return flatbuffers.VOffsetT((flatbuffers.VtableMetadataFields + index) * flatbuffers.SizeVOffsetT)
+}
+
 // MarshalUsers serializes a collection of Users to FlatBuffers bytes
 func (f *FlatBuffersSerializer) MarshalUsers(users models.Users) ([]byte, error) {
 	builder := flatbuffers.NewBuilder(1024 * len(users))
@@ -92,13 +194,22 @@ func (f *FlatBuffersSerializer) MarshalUsers(users models.Users) ([]byte, error)
 }
 
 // UnmarshalUsers deserializes FlatBuffers bytes to a collection of Users
-func (f *FlatBuffersSerializer) UnmarshalUsers(data []byte) (models.Users, error) {
-	userList := generated.GetRootAsUserList(data, 0)
+func (f *FlatBuffersSerializer) UnmarshalUsers(data []byte) (users models.Users, err error) {
+	defer recoverCorruptFlatBuffer(f.Name(), &err)
 
-	users := make(models.Users, userList.UsersLength())
+	userList, err := f.rootUserList(data)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := vectorLength(userList.Table(), userList.UsersLength())
+	if err != nil {
+		return nil, err
+	}
+	users = make(models.Users, count)
 	fbUser := new(generated.User)
 
-	for i := 0; i < userList.UsersLength(); i++ {
+	for i := 0; i < count; i++ {
 		if !userList.Users(fbUser, i) {
 			return nil, fmt.Errorf("failed to get user %d from flatbuffer", i)
 		}
@@ -113,6 +224,39 @@ func (f *FlatBuffersSerializer) UnmarshalUsers(data []byte) (models.Users, error
 	return users, nil
 }
 
+// rootUserList returns the root UserList of data after checking that data is large enough to
+// hold the root table offset, and that the whole buffer is well-formed if verification is enabled.
+// Unlike generated.GetRootAsUserList it returns the table by value, so it need not be allocated.
+func (f *FlatBuffersSerializer) rootUserList(data []byte) (userList generated.UserList, err error) {
+	if len(data) < flatbuffers.SizeUOffsetT {
+		return userList, fmt.Errorf("corrupt flatbuffer data: %d bytes", len(data))
+	}
+	if f.verify {
+		if err := verifyUserList(data); err != nil {
+			return userList, err
+		}
+	}
+	userList.Init(data, flatbuffers.GetUOffsetT(data))
+	return userList, nil
+}
+
+// vectorLength checks the length n of a vector in tab against the buffer size. Every element
+// takes at least 4 bytes, so a larger length is corrupt and would allocate without bound.
+func vectorLength(tab flatbuffers.Table, n int) (int, error) {
+	if n < 0 || n > len(tab.Bytes)/flatbuffers.SizeUOffsetT {
+		return 0, fmt.Errorf("corrupt flatbuffer data: vector length %d exceeds %d-byte buffer", n, len(tab.Bytes))
+	}
+	return n, nil
+}
+
+// recoverCorruptFlatBuffer turns a panic of the generated accessors, which do not check bounds,
+// into a PanicError. It must be deferred directly by the decoding function.
+func recoverCorruptFlatBuffer(serializer string, err *error) {
+	if r := recover(); r != nil {
+		*err = PanicError{Serializer: serializer, Value: r}
+	}
+}
+
 // convertUserToFlatBuffer converts a models.User to FlatBuffer format
 func (f *FlatBuffersSerializer) convertUserToFlatBuffer(builder *flatbuffers.Builder, user models.User) (flatbuffers.UOffsetT, error) {
 	// Create all nested objects first (deepest first)
@@ -122,7 +266,10 @@ func (f *FlatBuffersSerializer) convertUserToFlatBuffer(builder *flatbuffers.Bui
 	if len(user.Metadata) > 0 {
 		metadataOffsets := make([]flatbuffers.UOffsetT, 0, len(user.Metadata))
 		for key, value := range user.Metadata {
-			metadataOffset := f.convertMetadataEntryToFlatBuffer(builder, key, value)
+			metadataOffset, err := f.convertMetadataEntryToFlatBuffer(builder, key, value)
+			if err != nil {
+				return 0, err
+			}
 			metadataOffsets = append(metadataOffsets, metadataOffset)
 		}
 		generated.UserStartMetadataVector(builder, len(metadataOffsets))
@@ -158,6 +305,11 @@ func (f *FlatBuffersSerializer) convertUserToFlatBuffer(builder *flatbuffers.Bui
 		return 0, err
 	}
 
+	age, err := toInt32(f.Name(), "Age", user.Age)
+	if err != nil {
+		return 0, err
+	}
+
 	// Convert strings last
 	nameOffset := builder.CreateString(user.Name)
 	emailOffset := builder.CreateString(user.Email)
@@ -167,7 +319,7 @@ func (f *FlatBuffersSerializer) convertUserToFlatBuffer(builder *flatbuffers.Bui
 	generated.UserAddId(builder, user.ID)
 	generated.UserAddName(builder, nameOffset)
 	generated.UserAddEmail(builder, emailOffset)
-	generated.UserAddAge(builder, int32(user.Age))
+	generated.UserAddAge(builder, age)
 	generated.UserAddIsActive(builder, user.IsActive)
 	generated.UserAddProfile(builder, profileOffset)
 	generated.UserAddSettings(builder, settingsOffset)
@@ -298,11 +450,15 @@ func (f *FlatBuffersSerializer) convertSettingsToFlatBuffer(builder *flatbuffers
 	if len(settings.Limits) > 0 {
 		limitOffsets := make([]flatbuffers.UOffsetT, 0, len(settings.Limits))
 		for key, value := range settings.Limits {
+			limit, err := toInt32(f.Name(), "Settings.Limits."+key, value)
+			if err != nil {
+				return 0, err
+			}
 			keyOffset := builder.CreateString(key)
 
 			generated.LimitSettingStart(builder)
 			generated.LimitSettingAddKey(builder, keyOffset)
-			generated.LimitSettingAddValue(builder, int32(value))
+			generated.LimitSettingAddValue(builder, limit)
 			limitOffsets = append(limitOffsets, generated.LimitSettingEnd(builder))
 		}
 		generated.SettingsStartLimitsVector(builder, len(limitOffsets))
@@ -330,7 +486,15 @@ func (f *FlatBuffersSerializer) convertSettingsToFlatBuffer(builder *flatbuffers
 }
 
 // convertMetadataEntryToFlatBuffer converts a metadata key-value pair to FlatBuffer format
-func (f *FlatBuffersSerializer) convertMetadataEntryToFlatBuffer(builder *flatbuffers.Builder, key string, value interface{}) flatbuffers.UOffsetT {
+func (f *FlatBuffersSerializer) convertMetadataEntryToFlatBuffer(builder *flatbuffers.Builder, key string, value interface{}) (flatbuffers.UOffsetT, error) {
+	var intValue int32
+	if v, ok := value.(int); ok {
+		var err error
+		if intValue, err = toInt32(f.Name(), "Metadata."+key, v); err != nil {
+			return 0, err
+		}
+	}
+
 	keyOffset := builder.CreateString(key)
 	var stringValueOffset flatbuffers.UOffsetT
 
@@ -353,7 +517,7 @@ func (f *FlatBuffersSerializer) convertMetadataEntryToFlatBuffer(builder *flatbu
 		generated.MetadataEntryAddStringValue(builder, stringValueOffset)
 		generated.MetadataEntryAddValueType(builder, 0) // string
 	case int:
-		generated.MetadataEntryAddIntValue(builder, int32(v))
+		generated.MetadataEntryAddIntValue(builder, intValue)
 		generated.MetadataEntryAddValueType(builder, 1) // int
 	case bool:
 		generated.MetadataEntryAddBoolValue(builder, v)
@@ -370,7 +534,7 @@ func (f *FlatBuffersSerializer) convertMetadataEntryToFlatBuffer(builder *flatbu
 		generated.MetadataEntryAddValueType(builder, 0) // string
 	}
 
-	return generated.MetadataEntryEnd(builder)
+	return generated.MetadataEntryEnd(builder), nil
 }
 
 // convertFlatBufferToUser converts a FlatBuffer User to models.User
@@ -405,8 +569,12 @@ func (f *FlatBuffersSerializer) convertFlatBufferToUser(fbUser *generated.User)
 	}
 
 	// Convert Tags
-	user.Tags = make([]string, fbUser.TagsLength())
-	for i := 0; i < fbUser.TagsLength(); i++ {
+	tagCount, err := vectorLength(fbUser.Table(), fbUser.TagsLength())
+	if err != nil {
+		return models.User{}, err
+	}
+	user.Tags = make([]string, tagCount)
+	for i := 0; i < tagCount; i++ {
 		user.Tags[i] = string(fbUser.Tags(i))
 	}
 
@@ -446,9 +614,13 @@ func (f *FlatBuffersSerializer) convertFlatBufferToProfile(fbProfile *generated.
 	}
 
 	// Convert SocialLinks
-	profile.SocialLinks = make([]models.Link, fbProfile.SocialLinksLength())
+	linkCount, err := vectorLength(fbProfile.Table(), fbProfile.SocialLinksLength())
+	if err != nil {
+		return models.Profile{}, err
+	}
+	profile.SocialLinks = make([]models.Link, linkCount)
 	fbLink := new(generated.Link)
-	for i := 0; i < fbProfile.SocialLinksLength(); i++ {
+	for i := 0; i < linkCount; i++ {
 		if fbProfile.SocialLinks(fbLink, i) {
 			profile.SocialLinks[i] = models.Link{
 				Platform: string(fbLink.Platform()),
@@ -509,8 +681,12 @@ func (f *FlatBuffersSerializer) convertFlatBufferToSettings(fbSettings *generate
 	}
 
 	// Convert Features
-	settings.Features = make([]string, fbSettings.FeaturesLength())
-	for i := 0; i < fbSettings.FeaturesLength(); i++ {
+	featureCount, err := vectorLength(fbSettings.Table(), fbSettings.FeaturesLength())
+	if err != nil {
+		return models.Settings{}, err
+	}
+	settings.Features = make([]string, featureCount)
+	for i := 0; i < featureCount; i++ {
 		settings.Features[i] = string(fbSettings.Features(i))
 	}
 
@@ -0,0 +1,263 @@
+package serializers
+
+import (
+	"fmt"
+	"math"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+// flatBuffersVerifier walks the tables of a UserList buffer and checks every offset, vector and
+// string the generated accessors would read, so that decoding a verified buffer cannot index
+// out of range. The first failed check is kept in err and turns all later checks into no-ops.
+//
+// Unlike the C++ verifier it does not check alignment, as the Go accessors read
+// little-endian values at any offset.
+type flatBuffersVerifier struct {
+	buf []byte
+	// objects counts the tables, vectors and strings visited. Each takes at least 4 bytes, so
+	// a buffer that visits more than len(buf)/4 shares objects, which our encoder never
+	// writes and which would make verification time grow with the product of vector lengths.
+	objects int
+	err     error
+}
+
+// fbTable is a verified table: the position of the table and of its vtable
+type fbTable struct {
+	pos, vtable, vtableSize int
+}
+
+// verifyUserList checks that data is a well-formed UserList buffer
+func verifyUserList(data []byte) error {
+	v := &flatBuffersVerifier{buf: data}
+	if len(data) > math.MaxInt32 {
+		return fmt.Errorf("corrupt flatbuffer data: %d bytes exceeds the 2 GiB limit", len(data))
+	}
+	if !v.inBounds(0, flatbuffers.SizeUOffsetT, "root offset") {
+		return v.err
+	}
+	t := v.table(int(flatbuffers.GetUOffsetT(data)))
+	v.tables(t, 0, v.verifyUser) // users
+	return v.err
+}
+
+// verifyUser checks a User table
+func (v *flatBuffersVerifier) verifyUser(pos int) {
+	t := v.table(pos)
+	v.scalar(t, 0, flatbuffers.SizeInt64) // id
+	v.string(t, 1)                        // name
+	v.string(t, 2)                        // email
+	v.scalar(t, 3, flatbuffers.SizeInt32) // age
+	v.scalar(t, 4, flatbuffers.SizeBool)  // is_active
+	v.child(t, 5, v.verifyProfile)
+	v.child(t, 6, v.verifySettings)
+	v.strings(t, 7)                       // tags
+	v.tables(t, 8, v.verifyMetadataEntry) // metadata
+	v.scalar(t, 9, flatbuffers.SizeInt64) // created_at
+}
+
+// verifyProfile checks a Profile table
+func (v *flatBuffersVerifier) verifyProfile(pos int) {
+	t := v.table(pos)
+	for field := 0; field < 4; field++ { // first_name, last_name, bio, avatar
+		v.string(t, field)
+	}
+	v.tables(t, 4, v.verifyLink) // social_links
+	v.child(t, 5, v.verifyPreferences)
+}
+
+// verifyLink checks a Link table
+func (v *flatBuffersVerifier) verifyLink(pos int) {
+	t := v.table(pos)
+	v.string(t, 0) // platform
+	v.string(t, 1) // url
+}
+
+// verifyPreferences checks a Preferences table
+func (v *flatBuffersVerifier) verifyPreferences(pos int) {
+	t := v.table(pos)
+	v.string(t, 0)                              // theme
+	v.string(t, 1)                              // language
+	v.tables(t, 2, v.verifyNotificationSetting) // notifications
+	v.child(t, 3, v.verifyPrivacySettings)
+}
+
+// verifyNotificationSetting checks a NotificationSetting table
+func (v *flatBuffersVerifier) verifyNotificationSetting(pos int) {
+	t := v.table(pos)
+	v.string(t, 0)                       // key
+	v.scalar(t, 1, flatbuffers.SizeBool) // value
+}
+
+// verifyPrivacySettings checks a PrivacySettings table
+func (v *flatBuffersVerifier) verifyPrivacySettings(pos int) {
+	t := v.table(pos)
+	for field := 0; field < 3; field++ { // profile_public, email_visible, show_activity
+		v.scalar(t, field, flatbuffers.SizeBool)
+	}
+}
+
+// verifySettings checks a Settings table
+func (v *flatBuffersVerifier) verifySettings(pos int) {
+	t := v.table(pos)
+	v.string(t, 0)                       // language
+	v.string(t, 1)                       // timezone
+	v.strings(t, 2)                      // features
+	v.tables(t, 3, v.verifyLimitSetting) // limits
+}
+
+// verifyLimitSetting checks a LimitSetting table
+func (v *flatBuffersVerifier) verifyLimitSetting(pos int) {
+	t := v.table(pos)
+	v.string(t, 0)                        // key
+	v.scalar(t, 1, flatbuffers.SizeInt32) // value
+}
+
+// verifyMetadataEntry checks a MetadataEntry table
+func (v *flatBuffersVerifier) verifyMetadataEntry(pos int) {
+	t := v.table(pos)
+	v.string(t, 0)                          // key
+	v.string(t, 1)                          // string_value
+	v.scalar(t, 2, flatbuffers.SizeInt32)   // int_value
+	v.scalar(t, 3, flatbuffers.SizeBool)    // bool_value
+	v.scalar(t, 4, flatbuffers.SizeFloat64) // float_value
+	v.scalar(t, 5, flatbuffers.SizeUint8)   // value_type
+}
+
+// table checks the table at pos and its vtable
+func (v *flatBuffersVerifier) table(pos int) fbTable {
+	if !v.visit() || !v.inBounds(pos, flatbuffers.SizeSOffsetT, "table") {
+		return fbTable{}
+	}
+	vtable := pos - int(flatbuffers.GetSOffsetT(v.buf[pos:]))
+	if !v.inBounds(vtable, 2*flatbuffers.SizeVOffsetT, "vtable") {
+		return fbTable{}
+	}
+	size := int(flatbuffers.GetVOffsetT(v.buf[vtable:]))
+	if size < 2*flatbuffers.SizeVOffsetT || size%flatbuffers.SizeVOffsetT != 0 {
+		v.fail("vtable size %d", vtable, size)
+		return fbTable{}
+	}
+	if !v.inBounds(vtable, size, "vtable") {
+		return fbTable{}
+	}
+	return fbTable{pos: pos, vtable: vtable, vtableSize: size}
+}
+
+// field returns the position of field in t, or 0 if it is absent or verification has failed
+func (v *flatBuffersVerifier) field(t fbTable, field int) int {
+	slot := int(flatBufferSlot(field))
+	if v.err != nil || slot >= t.vtableSize {
+		return 0
+	}
+	offset := int(flatbuffers.GetVOffsetT(v.buf[t.vtable+slot:]))
+	if offset == 0 {
+		return 0
+	}
+	return t.pos + offset
+}
+
+// scalar checks that the scalar field of the given size lies within the buffer
+func (v *flatBuffersVerifier) scalar(t fbTable, field, size int) {
+	if pos := v.field(t, field); pos != 0 {
+		v.inBounds(pos, size, "scalar field")
+	}
+}
+
+// target follows the offset stored in field and returns the position it points to, or 0
+func (v *flatBuffersVerifier) target(t fbTable, field int) int {
+	pos := v.field(t, field)
+	if pos == 0 || !v.inBounds(pos, flatbuffers.SizeUOffsetT, "offset field") {
+		return 0
+	}
+	return pos + int(flatbuffers.GetUOffsetT(v.buf[pos:]))
+}
+
+// child checks the table referenced by field with verify
+func (v *flatBuffersVerifier) child(t fbTable, field int, verify func(pos int)) {
+	if pos := v.target(t, field); pos != 0 {
+		verify(pos)
+	}
+}
+
+// string checks the string referenced by field
+func (v *flatBuffersVerifier) string(t fbTable, field int) {
+	if pos := v.target(t, field); pos != 0 {
+		v.stringAt(pos)
+	}
+}
+
+// strings checks the vector of strings referenced by field and each of its strings
+func (v *flatBuffersVerifier) strings(t fbTable, field int) {
+	v.vector(t, field, v.stringAt)
+}
+
+// tables checks the vector of tables referenced by field and each of its tables with verify
+func (v *flatBuffersVerifier) tables(t fbTable, field int, verify func(pos int)) {
+	v.vector(t, field, verify)
+}
+
+// vector checks the vector of offsets referenced by field and calls verify with the position
+// each element points to
+func (v *flatBuffersVerifier) vector(t fbTable, field int, verify func(pos int)) {
+	pos := v.target(t, field)
+	if pos == 0 || !v.visit() || !v.inBounds(pos, flatbuffers.SizeUOffsetT, "vector length") {
+		return
+	}
+	n := int(flatbuffers.GetUOffsetT(v.buf[pos:]))
+	start := pos + flatbuffers.SizeUOffsetT
+	if n > (len(v.buf)-start)/flatbuffers.SizeUOffsetT {
+		v.fail("vector of %d elements", pos, n)
+		return
+	}
+	for i := 0; i < n && v.err == nil; i++ {
+		elem := start + i*flatbuffers.SizeUOffsetT
+		verify(elem + int(flatbuffers.GetUOffsetT(v.buf[elem:])))
+	}
+}
+
+// stringAt checks the length, bytes and NUL terminator of the string at pos
+func (v *flatBuffersVerifier) stringAt(pos int) {
+	if !v.visit() || !v.inBounds(pos, flatbuffers.SizeUOffsetT, "string length") {
+		return
+	}
+	n := int(flatbuffers.GetUOffsetT(v.buf[pos:]))
+	end := pos + flatbuffers.SizeUOffsetT + n
+	if !v.inBounds(pos, flatbuffers.SizeUOffsetT+n+1, "string") {
+		return
+	}
+	if v.buf[end] != 0 {
+		v.fail("string of %d bytes is not NUL-terminated", pos, n)
+	}
+}
+
+// visit counts an object and fails once more objects were visited than the buffer can hold
+func (v *flatBuffersVerifier) visit() bool {
+	if v.err != nil {
+		return false
+	}
+	v.objects++
+	if v.objects > len(v.buf)/flatbuffers.SizeUOffsetT {
+		v.fail("more than %d objects", 0, len(v.buf)/flatbuffers.SizeUOffsetT)
+		return false
+	}
+	return true
+}
+
+// inBounds reports whether size bytes at pos lie within the buffer, failing with what otherwise
+func (v *flatBuffersVerifier) inBounds(pos, size int, what string) bool {
+	if v.err != nil {
+		return false
+	}
+	if pos < 0 || size > len(v.buf)-pos {
+		v.err = fmt.Errorf("corrupt flatbuffer data: %s at offset %d (%d bytes) exceeds %d-byte buffer",
+			what, pos, size, len(v.buf))
+		return false
+	}
+	return true
+}
+
+// fail records a failed check of the object at pos
+func (v *flatBuffersVerifier) fail(format string, pos int, args ...any) {
+	v.err = fmt.Errorf("corrupt flatbuffer data: %s at offset %d", fmt.Sprintf(format, args...), pos)
+}
@@ -0,0 +1,217 @@
+package serializers
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"slices"
+	"testing"
+
+	generated "github.com/tomotakashimizu/go-serialization-benchmarks/internal/flatbuffers/generated"
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/models"
+)
+
+// The fuzz targets decode arbitrary bytes with every registered and optional serializer, as data
+// read back from Redis may be corrupt or written by another version. The first argument selects
+// the serializer by fuzzTargets index. Fuzz a single target with, for example:
+//
+//	go test -run '^$' -fuzz FuzzUnmarshal$ -fuzztime 1m ./internal/serializers
+const (
+	fuzzSeedUsers = 4 // generated users encoded as seeds per serializer
+
+	// fuzzAllocBase and fuzzAllocPerByte bound the bytes a decode may allocate:
+	// fuzzAllocBase + fuzzAllocPerByte*len(data)
+	fuzzAllocBase    = 4 << 20
+	fuzzAllocPerByte = 1024
+)
+
+// fuzzTargets lists the serializers the fuzz targets decode with
+var fuzzTargets = slices.Concat(registry, optional)
+
+// FuzzUnmarshal checks that Unmarshal never panics or allocates without bound and that every
+// user it decodes re-encodes consistently
+func FuzzUnmarshal(f *testing.F) {
+	users := models.GenerateTestUsers(fuzzSeedUsers, 1, models.ProfileTypical)
+	for i, r := range fuzzTargets {
+		ser := r.New()
+		for _, user := range users {
+			data, err := ser.Marshal(user)
+			if err != nil {
+				f.Fatalf("%s: Marshal of user %d: %v", r.Name, user.ID, err)
+			}
+			f.Add(uint8(i), data)
+		}
+		f.Add(uint8(i), []byte{})
+	}
+
+	f.Fuzz(func(t *testing.T, index uint8, data []byte) {
+		ser := fuzzTargets[int(index)%len(fuzzTargets)].New()
+
+		var user models.User
+		var err error
+		checkAllocs(t, ser, data, func() { user, err = ser.Unmarshal(data) })
+		if err != nil {
+			return
+		}
+		checkReencode(t, ser, models.Users{user}, func(users models.Users) ([]byte, error) {
+			return ser.Marshal(users[0])
+		}, func(data []byte) (models.Users, error) {
+			user, err := ser.Unmarshal(data)
+			return models.Users{user}, err
+		})
+	})
+}
+
+// FuzzUnmarshalUsers checks that UnmarshalUsers never panics or allocates without bound and
+// that every collection it decodes re-encodes consistently
+func FuzzUnmarshalUsers(f *testing.F) {
+	users := models.GenerateTestUsers(fuzzSeedUsers, 1, models.ProfileTypical)
+	for i, r := range fuzzTargets {
+		ser := r.New()
+		for n := 0; n <= len(users); n += 2 {
+			data, err := ser.MarshalUsers(users[:n])
+			if err != nil {
+				f.Fatalf("%s: MarshalUsers of %d users: %v", r.Name, n, err)
+			}
+			f.Add(uint8(i), data)
+		}
+		f.Add(uint8(i), []byte{})
+	}
+
+	f.Fuzz(func(t *testing.T, index uint8, data []byte) {
+		ser := fuzzTargets[int(index)%len(fuzzTargets)].New()
+
+		var users models.Users
+		var err error
+		checkAllocs(t, ser, data, func() { users, err = ser.UnmarshalUsers(data) })
+		if err != nil {
+			return
+		}
+		checkReencode(t, ser, users, ser.MarshalUsers, ser.UnmarshalUsers)
+	})
+}
+
+// FuzzReadFields checks that ReadFields reads every field of the seed users correctly and that it
+// never panics on arbitrary bytes
+func FuzzReadFields(f *testing.F) {
+	fields := make([]Field, len(partialFields))
+	for i := range fields {
+		fields[i] = Field(i)
+	}
+	values := make([]FieldValue, len(fields))
+
+	users := models.GenerateTestUsers(fuzzSeedUsers, 1, models.ProfileTypical)
+	for i, r := range fuzzTargets {
+		ser := r.New()
+		reader, ok := ser.(PartialReader)
+		if !ok {
+			continue
+		}
+		for _, user := range users {
+			data, err := ser.Marshal(user)
+			if err != nil {
+				f.Fatalf("%s: Marshal of user %d: %v", r.Name, user.ID, err)
+			}
+			if err := reader.ReadFields(data, fields, values); err != nil {
+				f.Fatalf("%s: ReadFields of user %d: %v", r.Name, user.ID, err)
+			}
+			for j, field := range fields {
+				if want := field.Value(user); !values[j].Equal(want) {
+					f.Fatalf("%s: user %d: ReadFields read %s = %+v, want %+v", r.Name, user.ID, field, values[j], want)
+				}
+			}
+			f.Add(uint8(i), data)
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, index uint8, data []byte) {
+		reader, ok := fuzzTargets[int(index)%len(fuzzTargets)].New().(PartialReader)
+		if !ok {
+			return
+		}
+		_ = reader.ReadFields(data, fields, values)
+	})
+}
+
+// FuzzVerifyFlatBuffers checks that the FlatBuffers verifier accepts every buffer the encoder
+// writes and that decoding a buffer it accepts never panics, without the recover that
+// Unmarshal defers as a fallback
+func FuzzVerifyFlatBuffers(f *testing.F) {
+	ser := NewFlatBuffersSerializer()
+	users := models.GenerateTestUsers(fuzzSeedUsers, 1, models.ProfileTypical)
+	for n := 0; n <= len(users); n++ {
+		data, err := ser.MarshalUsers(users[:n])
+		if err != nil {
+			f.Fatalf("MarshalUsers of %d users: %v", n, err)
+		}
+		if err := verifyUserList(data); err != nil {
+			f.Fatalf("verifying %d users: %v", n, err)
+		}
+		f.Add(data)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if verifyUserList(data) != nil {
+			return
+		}
+		userList := generated.GetRootAsUserList(data, 0)
+		fbUser := new(generated.User)
+		for i := 0; i < userList.UsersLength(); i++ {
+			if !userList.Users(fbUser, i) {
+				t.Fatalf("user %d of %d missing from verified buffer", i, userList.UsersLength())
+			}
+			// Errors such as unknown metadata types are fine; only a panic fails
+			_, _ = ser.convertFlatBufferToUser(fbUser)
+		}
+	})
+}
+
+// checkAllocs runs decode twice and fails if the second run allocated more than the bound for
+// data. The first run absorbs one-time costs such as codecs built on first use of a type.
+func checkAllocs(t *testing.T, ser Serializer, data []byte, decode func()) {
+	t.Helper()
+
+	decode()
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	decode()
+	runtime.ReadMemStats(&after)
+
+	limit := uint64(fuzzAllocBase + fuzzAllocPerByte*len(data))
+	if allocated := after.TotalAlloc - before.TotalAlloc; allocated > limit {
+		t.Fatalf("%s: decoding %d bytes allocated %d bytes (limit %d)", ser.Name(), len(data), allocated, limit)
+	}
+}
+
+// checkReencode encodes decoded users and decodes them again twice. The first round trip may
+// normalize the users (e.g. nil slices to empty ones), so the second must reproduce its result.
+func checkReencode(t *testing.T, ser Serializer, decoded models.Users,
+	encode func(models.Users) ([]byte, error), decode func([]byte) (models.Users, error)) {
+	t.Helper()
+
+	first, err := roundTripUsers(decoded, encode, decode)
+	if err != nil {
+		t.Fatalf("%s: re-encoding decoded users: %v", ser.Name(), err)
+	}
+	second, err := roundTripUsers(first, encode, decode)
+	if err != nil {
+		t.Fatalf("%s: re-encoding round-tripped users: %v", ser.Name(), err)
+	}
+	if !reflect.DeepEqual(first, second) {
+		t.Fatalf("%s: re-encoding is not consistent:\nfirst:  %+v\nsecond: %+v", ser.Name(), first, second)
+	}
+}
+
+// roundTripUsers encodes and decodes users
+func roundTripUsers(users models.Users, encode func(models.Users) ([]byte, error),
+	decode func([]byte) (models.Users, error)) (models.Users, error) {
+	data, err := encode(users)
+	if err != nil {
+		return nil, fmt.Errorf("encode: %w", err)
+	}
+	restored, err := decode(data)
+	if err != nil {
+		return nil, fmt.Errorf("decode: %w", err)
+	}
+	return restored, nil
+}
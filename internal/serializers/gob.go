@@ -3,10 +3,17 @@ package serializers
 import (
 	"bytes"
 	"encoding/gob"
+	"io"
 
 	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/models"
 )
 
+func init() {
+	// Nested metadata values are sent as interface{} and must be registered
+	gob.Register(map[string]interface{}{})
+	gob.Register([]interface{}{})
+}
+
 // GobSerializer implements Serializer interface for Gob
 type GobSerializer struct{}
 
@@ -59,3 +66,13 @@ func (g *GobSerializer) UnmarshalUsers(data []byte) (models.Users, error) {
 	err := dec.Decode(&users)
 	return users, err
 }
+
+// NewStreamEncoder creates an encoder writing a Gob stream of users that shares type information
+func (g *GobSerializer) NewStreamEncoder(w io.Writer) StreamEncoder {
+	return valueEncoder{gob.NewEncoder(w)}
+}
+
+// NewStreamDecoder creates a decoder reading a Gob stream of users
+func (g *GobSerializer) NewStreamDecoder(r io.Reader) StreamDecoder {
+	return valueDecoder{gob.NewDecoder(r)}
+}
@@ -31,6 +31,11 @@ func (g *GoJSONSerializer) Unmarshal(data []byte) (models.User, error) {
 	return user, err
 }
 
+// ReadFields reads fields from JSON bytes without decoding the rest of the user
+func (g *GoJSONSerializer) ReadFields(data []byte, fields []Field, values []FieldValue) error {
+	return readJSONFields(data, fields, values)
+}
+
 // MarshalUsers serializes a collection of Users to JSON bytes using goccy/go-json
 func (g *GoJSONSerializer) MarshalUsers(users models.Users) ([]byte, error) {
 	return gojson.Marshal(users)
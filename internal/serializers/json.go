@@ -2,6 +2,7 @@ package serializers
 
 import (
 	"encoding/json"
+	"io"
 
 	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/models"
 )
@@ -31,6 +32,11 @@ func (j *JSONSerializer) Unmarshal(data []byte) (models.User, error) {
 	return user, err
 }
 
+// ReadFields reads fields from JSON bytes without decoding the rest of the user
+func (j *JSONSerializer) ReadFields(data []byte, fields []Field, values []FieldValue) error {
+	return readJSONFields(data, fields, values)
+}
+
 // MarshalUsers serializes a collection of Users to JSON bytes
 func (j *JSONSerializer) MarshalUsers(users models.Users) ([]byte, error) {
 	return json.Marshal(users)
@@ -42,3 +48,13 @@ func (j *JSONSerializer) UnmarshalUsers(data []byte) (models.Users, error) {
 	err := json.Unmarshal(data, &users)
 	return users, err
 }
+
+// NewStreamEncoder creates an encoder writing one JSON user per line (NDJSON)
+func (j *JSONSerializer) NewStreamEncoder(w io.Writer) StreamEncoder {
+	return valueEncoder{json.NewEncoder(w)}
+}
+
+// NewStreamDecoder creates a decoder reading a stream of JSON users
+func (j *JSONSerializer) NewStreamDecoder(r io.Reader) StreamDecoder {
+	return valueDecoder{json.NewDecoder(r)}
+}
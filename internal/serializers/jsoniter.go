@@ -36,6 +36,11 @@ func (j *JSONiterSerializer) Unmarshal(data []byte) (models.User, error) {
 	return user, err
 }
 
+// ReadFields reads fields from JSON bytes without decoding the rest of the user
+func (j *JSONiterSerializer) ReadFields(data []byte, fields []Field, values []FieldValue) error {
+	return readJSONFields(data, fields, values)
+}
+
 // MarshalUsers serializes a collection of Users to JSON bytes using json-iterator
 func (j *JSONiterSerializer) MarshalUsers(users models.Users) ([]byte, error) {
 	return j.json.Marshal(users)
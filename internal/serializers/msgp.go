@@ -1,6 +1,10 @@
 package serializers
 
 import (
+	"fmt"
+	"io"
+
+	"github.com/tinylib/msgp/msgp"
 	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/models"
 )
 
@@ -29,6 +33,89 @@ func (m *MsgpSerializer) Unmarshal(data []byte) (models.User, error) {
 	return user, err
 }
 
+// ReadFields reads fields from MessagePack bytes, stepping over map keys with ReadMapKeyZC and
+// skipping the values of other keys with msgp.Skip. Strings are returned without copying.
+func (m *MsgpSerializer) ReadFields(data []byte, fields []Field, values []FieldValue) error {
+	if err := checkFieldValues(fields, values); err != nil {
+		return err
+	}
+	for i, field := range fields {
+		f := partialFields[field]
+		raw, err := msgpLookup(data, f.keys)
+		if err != nil {
+			return fmt.Errorf("%s: %w", f.path, err)
+		}
+		if values[i], err = msgpFieldValue(raw, f.kind); err != nil {
+			return fmt.Errorf("%s: %w", f.path, err)
+		}
+	}
+	return nil
+}
+
+// msgpLookup returns data from the encoded value at the path of map keys on, or nil if a key
+// is missing or a map on the path is nil
+func msgpLookup(data []byte, keys []string) ([]byte, error) {
+	value := data
+	for _, key := range keys {
+		if msgp.IsNil(value) {
+			return nil, nil
+		}
+		n, rest, err := msgp.ReadMapHeaderBytes(value)
+		if err != nil {
+			return nil, err
+		}
+
+		value = nil
+		for ; n > 0; n-- {
+			var name []byte
+			if name, rest, err = msgp.ReadMapKeyZC(rest); err != nil {
+				return nil, err
+			}
+			if string(name) == key {
+				value = rest
+				break
+			}
+			if rest, err = msgp.Skip(rest); err != nil {
+				return nil, err
+			}
+		}
+		if value == nil {
+			return nil, nil
+		}
+	}
+	return value, nil
+}
+
+// msgpFieldValue reads the encoded value of a field of the given kind at the start of raw. A
+// missing (nil) or nil value is the zero value.
+func msgpFieldValue(raw []byte, kind fieldKind) (FieldValue, error) {
+	if raw == nil || msgp.IsNil(raw) {
+		return FieldValue{}, nil
+	}
+	switch kind {
+	case kindInt64, kindInt32:
+		n, _, err := msgp.ReadInt64Bytes(raw)
+		return FieldValue{Int: n}, err
+	case kindBool:
+		b, _, err := msgp.ReadBoolBytes(raw)
+		return FieldValue{Bool: b}, err
+	default:
+		s, _, err := msgp.ReadStringZC(raw)
+		return FieldValue{Bytes: s}, err
+	}
+}
+
+// AppendMarshal appends the MessagePack encoding of a User to dst using tinylib/msgp
+func (m *MsgpSerializer) AppendMarshal(dst []byte, user models.User) ([]byte, error) {
+	return user.MarshalMsg(dst)
+}
+
+// UnmarshalInto deserializes MessagePack bytes into an existing User using tinylib/msgp
+func (m *MsgpSerializer) UnmarshalInto(data []byte, user *models.User) error {
+	_, err := user.UnmarshalMsg(data)
+	return err
+}
+
 // MarshalUsers serializes a collection of Users to MessagePack bytes using tinylib/msgp
 func (m *MsgpSerializer) MarshalUsers(users models.Users) ([]byte, error) {
 	return users.MarshalMsg(nil)
@@ -40,3 +127,45 @@ func (m *MsgpSerializer) UnmarshalUsers(data []byte) (models.Users, error) {
 	_, err := users.UnmarshalMsg(data)
 	return users, err
 }
+
+// NewStreamEncoder creates an encoder writing a sequence of MessagePack users using msgp.Writer
+func (m *MsgpSerializer) NewStreamEncoder(w io.Writer) StreamEncoder {
+	return msgpStreamEncoder{msgp.NewWriter(w)}
+}
+
+// NewStreamDecoder creates a decoder reading a sequence of MessagePack users using msgp.Reader
+func (m *MsgpSerializer) NewStreamDecoder(r io.Reader) StreamDecoder {
+	return msgpStreamDecoder{msgp.NewReader(r)}
+}
+
+// msgpStreamEncoder implements StreamEncoder for tinylib/msgp
+type msgpStreamEncoder struct {
+	w *msgp.Writer
+}
+
+// Encode encodes a single user
+func (e msgpStreamEncoder) Encode(user models.User) error {
+	return user.EncodeMsg(e.w)
+}
+
+// Flush writes the data buffered by msgp.Writer
+func (e msgpStreamEncoder) Flush() error {
+	return e.w.Flush()
+}
+
+// msgpStreamDecoder implements StreamDecoder for tinylib/msgp
+type msgpStreamDecoder struct {
+	r *msgp.Reader
+}
+
+// Decode decodes a single user
+func (d msgpStreamDecoder) Decode() (models.User, error) {
+	// DecodeMsg does not distinguish a clean end of stream from a truncated user
+	if _, err := d.r.R.Peek(1); err != nil {
+		return models.User{}, err
+	}
+
+	var user models.User
+	err := user.DecodeMsg(d.r)
+	return user, err
+}
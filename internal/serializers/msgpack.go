@@ -1,6 +1,11 @@
 package serializers
 
 import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+
 	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/models"
 	"github.com/vmihailenco/msgpack/v5"
 )
@@ -30,6 +35,25 @@ func (m *MsgPackSerializer) Unmarshal(data []byte) (models.User, error) {
 	return user, err
 }
 
+// AppendMarshal appends the MessagePack encoding of a User to dst using a pooled encoder
+func (m *MsgPackSerializer) AppendMarshal(dst []byte, user models.User) ([]byte, error) {
+	buf := bytes.NewBuffer(dst)
+	enc := msgpack.GetEncoder()
+	defer msgpack.PutEncoder(enc)
+
+	enc.Reset(buf)
+	if err := enc.Encode(user); err != nil {
+		return dst, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalInto deserializes MessagePack bytes into an existing User
+func (m *MsgPackSerializer) UnmarshalInto(data []byte, user *models.User) error {
+	resetUser(user)
+	return msgpack.Unmarshal(data, user)
+}
+
 // MarshalUsers serializes a collection of Users to MessagePack bytes
 func (m *MsgPackSerializer) MarshalUsers(users models.Users) ([]byte, error) {
 	return msgpack.Marshal(users)
@@ -41,3 +65,36 @@ func (m *MsgPackSerializer) UnmarshalUsers(data []byte) (models.Users, error) {
 	err := msgpack.Unmarshal(data, &users)
 	return users, err
 }
+
+// NewStreamEncoder creates an encoder writing a sequence of MessagePack users
+func (m *MsgPackSerializer) NewStreamEncoder(w io.Writer) StreamEncoder {
+	return valueEncoder{msgpack.NewEncoder(w)}
+}
+
+// NewStreamDecoder creates a decoder reading a sequence of MessagePack users
+func (m *MsgPackSerializer) NewStreamDecoder(r io.Reader) StreamDecoder {
+	br := bufio.NewReader(r)
+	return msgpackStreamDecoder{r: br, dec: msgpack.NewDecoder(br)}
+}
+
+// msgpackStreamDecoder implements StreamDecoder for vmihailenco/msgpack
+type msgpackStreamDecoder struct {
+	r   *bufio.Reader
+	dec *msgpack.Decoder
+}
+
+// Decode decodes a single user
+func (d msgpackStreamDecoder) Decode() (models.User, error) {
+	// msgpack.Decoder returns io.EOF for a user truncated at any point, not only at a clean
+	// end of stream
+	if _, err := d.r.Peek(1); err != nil {
+		return models.User{}, err
+	}
+
+	var user models.User
+	err := d.dec.Decode(&user)
+	if errors.Is(err, io.EOF) {
+		err = io.ErrUnexpectedEOF
+	}
+	return user, err
+}
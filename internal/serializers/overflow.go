@@ -0,0 +1,27 @@
+package serializers
+
+import (
+	"fmt"
+	"math"
+)
+
+// OverflowError reports an integer that does not fit the narrower type of a schema field
+type OverflowError struct {
+	Serializer string
+	Field      string // field path, e.g. "Age" or "Settings.Limits.max_posts"
+	Value      int64
+	Type       string // schema type, e.g. "int32"
+}
+
+// Error returns the error message naming the field, value and schema type
+func (e OverflowError) Error() string {
+	return fmt.Sprintf("%s: %s value %d overflows %s", e.Serializer, e.Field, e.Value, e.Type)
+}
+
+// toInt32 converts the value of field to int32, returning an OverflowError if it does not fit
+func toInt32(serializer, field string, value int) (int32, error) {
+	if value < math.MinInt32 || value > math.MaxInt32 {
+		return 0, OverflowError{Serializer: serializer, Field: field, Value: int64(value), Type: "int32"}
+	}
+	return int32(value), nil
+}
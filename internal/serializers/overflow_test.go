@@ -0,0 +1,106 @@
+package serializers
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/models"
+)
+
+// TestInt32Overflow checks that the schema-based serializers reject values that do not fit
+// their int32 fields with an OverflowError naming the field path
+func TestInt32Overflow(t *testing.T) {
+	base := models.User{ID: 1, Name: "Overflow", Settings: models.Settings{Language: "en"}}
+	withAge := func(age int) models.User {
+		user := base
+		user.Age = age
+		return user
+	}
+	withLimit := func(limit int) models.User {
+		user := base
+		user.Settings.Limits = map[string]int{"max_posts": limit}
+		return user
+	}
+	withMetadata := func(value int) models.User {
+		user := base
+		user.Metadata = map[string]interface{}{"score": value}
+		return user
+	}
+
+	tests := []struct {
+		name  string
+		user  models.User
+		field string
+		value int64
+	}{
+		{"Age above", withAge(math.MaxInt32 + 1), "Age", math.MaxInt32 + 1},
+		{"Age below", withAge(math.MinInt32 - 1), "Age", math.MinInt32 - 1},
+		{"Age max int64", withAge(math.MaxInt64), "Age", math.MaxInt64},
+		{"Limits above", withLimit(1 << 40), "Settings.Limits.max_posts", 1 << 40},
+		{"Limits below", withLimit(math.MinInt32 - 1), "Settings.Limits.max_posts", math.MinInt32 - 1},
+		{"Metadata above", withMetadata(math.MaxInt32 + 1), "Metadata.score", math.MaxInt32 + 1},
+		{"Metadata below", withMetadata(math.MinInt64), "Metadata.score", math.MinInt64},
+	}
+	for _, ser := range []Serializer{NewProtobufSerializer(), NewFlatBuffersSerializer()} {
+		for _, tt := range tests {
+			t.Run(ser.Name()+"/"+tt.name, func(t *testing.T) {
+				// Protobuf stores Metadata values as JSON strings, so they have no int32 field
+				if _, ok := ser.(*ProtobufSerializer); ok && tt.user.Metadata != nil {
+					if _, err := ser.Marshal(tt.user); err != nil {
+						t.Errorf("Marshal failed: %v", err)
+					}
+					return
+				}
+
+				_, err := ser.Marshal(tt.user)
+				var overflow OverflowError
+				if !errors.As(err, &overflow) {
+					t.Fatalf("Marshal error = %v, want an OverflowError", err)
+				}
+				want := OverflowError{Serializer: ser.Name(), Field: tt.field, Value: tt.value, Type: "int32"}
+				if overflow != want {
+					t.Errorf("Marshal error = %+v, want %+v", overflow, want)
+				}
+
+				if _, err := ser.MarshalUsers(models.Users{base, tt.user}); !errors.As(err, &overflow) {
+					t.Errorf("MarshalUsers error = %v, want an OverflowError", err)
+				}
+			})
+		}
+	}
+}
+
+// TestInt32Boundaries checks that values at the int32 limits still round-trip through the
+// schema-based serializers
+func TestInt32Boundaries(t *testing.T) {
+	for _, ser := range []Serializer{NewProtobufSerializer(), NewFlatBuffersSerializer()} {
+		for _, value := range []int{math.MaxInt32, math.MinInt32, -math.MaxInt32} {
+			user := models.User{
+				ID:       1,
+				Name:     "Boundary",
+				Age:      value,
+				Settings: models.Settings{Language: "en", Limits: map[string]int{"max_posts": value}},
+			}
+			if _, ok := ser.(*FlatBuffersSerializer); ok {
+				user.Metadata = map[string]interface{}{"score": value}
+			}
+
+			data, err := ser.Marshal(user)
+			if err != nil {
+				t.Fatalf("%s: Marshal of %d failed: %v", ser.Name(), value, err)
+			}
+			got, err := ser.Unmarshal(data)
+			if err != nil {
+				t.Fatalf("%s: Unmarshal of %d failed: %v", ser.Name(), value, err)
+			}
+			if got.Age != value || got.Settings.Limits["max_posts"] != value {
+				t.Errorf("%s: %d round-tripped to Age %d, Limits %v", ser.Name(), value, got.Age, got.Settings.Limits)
+			}
+			if user.Metadata != nil && !reflect.DeepEqual(got.Metadata, user.Metadata) {
+				t.Errorf("%s: Metadata %v round-tripped to %v", ser.Name(), user.Metadata, got.Metadata)
+			}
+		}
+	}
+}
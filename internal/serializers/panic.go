@@ -0,0 +1,15 @@
+package serializers
+
+import "fmt"
+
+// PanicError reports a panic of a decoder that the serializer recovered and returned as an error,
+// so callers can tell a decoder that crashed on invalid input from one that rejected it cleanly
+type PanicError struct {
+	Serializer string
+	Value      any // value passed to panic
+}
+
+// Error returns the error message naming the serializer and the panic value
+func (e PanicError) Error() string {
+	return fmt.Sprintf("%s: recovered panic decoding corrupt data: %v", e.Serializer, e.Value)
+}
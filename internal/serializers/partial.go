@@ -0,0 +1,130 @@
+package serializers
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/models"
+)
+
+// PartialReader is implemented by serializers that can read selected fields of a User from
+// its Marshal output natively, without decoding the rest of the user
+type PartialReader interface {
+	// ReadFields stores the value of fields[i] in values[i]. values must be as long as fields.
+	ReadFields(data []byte, fields []Field, values []FieldValue) error
+}
+
+// Field is a User field that partial readers can read, identified by its Go field path
+type Field int
+
+// FieldValue is the value of a Field: Int for integer fields, Bool for boolean fields and
+// Bytes for string fields. Bytes may alias the encoded data.
+type FieldValue struct {
+	Int   int64
+	Bool  bool
+	Bytes []byte
+}
+
+// Equal reports whether v and other hold the same value
+func (v FieldValue) Equal(other FieldValue) bool {
+	return v.Int == other.Int && v.Bool == other.Bool && bytes.Equal(v.Bytes, other.Bytes)
+}
+
+// fieldKind is the encoded type of a partial field
+type fieldKind int
+
+const (
+	kindInt64 fieldKind = iota
+	kindInt32
+	kindBool
+	kindString
+)
+
+// partialField describes a readable field and where each format stores it
+type partialField struct {
+	path  string             // Go field path, e.g. "Profile.FirstName"
+	kind  fieldKind          // type in the schemas
+	keys  []string           // JSON and MessagePack object keys
+	proto []protowire.Number // Protobuf field numbers
+	slots []int              // FlatBuffers field indexes
+	value func(models.User) FieldValue
+}
+
+// partialFields lists the fields partial readers support, indexed by Field. Slices and maps are
+// left out, as their elements have no fixed path.
+var partialFields = []partialField{
+	{"ID", kindInt64, []string{"id"}, []protowire.Number{1}, []int{0},
+		func(u models.User) FieldValue { return FieldValue{Int: u.ID} }},
+	{"Name", kindString, []string{"name"}, []protowire.Number{2}, []int{1},
+		func(u models.User) FieldValue { return FieldValue{Bytes: []byte(u.Name)} }},
+	{"Email", kindString, []string{"email"}, []protowire.Number{3}, []int{2},
+		func(u models.User) FieldValue { return FieldValue{Bytes: []byte(u.Email)} }},
+	{"Age", kindInt32, []string{"age"}, []protowire.Number{4}, []int{3},
+		func(u models.User) FieldValue { return FieldValue{Int: int64(u.Age)} }},
+	{"IsActive", kindBool, []string{"is_active"}, []protowire.Number{5}, []int{4},
+		func(u models.User) FieldValue { return FieldValue{Bool: u.IsActive} }},
+	{"Profile.FirstName", kindString, []string{"profile", "first_name"}, []protowire.Number{6, 1}, []int{5, 0},
+		func(u models.User) FieldValue { return FieldValue{Bytes: []byte(u.Profile.FirstName)} }},
+	{"Profile.LastName", kindString, []string{"profile", "last_name"}, []protowire.Number{6, 2}, []int{5, 1},
+		func(u models.User) FieldValue { return FieldValue{Bytes: []byte(u.Profile.LastName)} }},
+	{"Profile.Preferences.Theme", kindString, []string{"profile", "preferences", "theme"}, []protowire.Number{6, 6, 1}, []int{5, 5, 0},
+		func(u models.User) FieldValue { return FieldValue{Bytes: []byte(u.Profile.Preferences.Theme)} }},
+	{"Profile.Preferences.Privacy.ProfilePublic", kindBool, []string{"profile", "preferences", "privacy", "profile_public"}, []protowire.Number{6, 6, 4, 1}, []int{5, 5, 3, 0},
+		func(u models.User) FieldValue { return FieldValue{Bool: u.Profile.Preferences.Privacy.ProfilePublic} }},
+	{"Settings.Language", kindString, []string{"settings", "language"}, []protowire.Number{7, 1}, []int{6, 0},
+		func(u models.User) FieldValue { return FieldValue{Bytes: []byte(u.Settings.Language)} }},
+	{"Settings.TimeZone", kindString, []string{"settings", "timezone"}, []protowire.Number{7, 2}, []int{6, 1},
+		func(u models.User) FieldValue { return FieldValue{Bytes: []byte(u.Settings.TimeZone)} }},
+}
+
+// String returns the Go field path of f
+func (f Field) String() string {
+	return partialFields[f].path
+}
+
+// Value returns the value of f in user, as a PartialReader would read it
+func (f Field) Value(user models.User) FieldValue {
+	return partialFields[f].value(user)
+}
+
+// FieldPaths returns the Go field paths of all fields partial readers support
+func FieldPaths() []string {
+	paths := make([]string, len(partialFields))
+	for i, field := range partialFields {
+		paths[i] = field.path
+	}
+	return paths
+}
+
+// ParseFields parses a comma-separated list of Go field paths (case-insensitive)
+func ParseFields(s string) ([]Field, error) {
+	var fields []Field
+	for _, path := range ParsePatterns(s) {
+		found := false
+		for i, field := range partialFields {
+			if strings.EqualFold(field.path, path) {
+				fields = append(fields, Field(i))
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown field %q (supported: %s)", path, strings.Join(FieldPaths(), ", "))
+		}
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no fields specified")
+	}
+	return fields, nil
+}
+
+// checkFieldValues returns an error if values cannot hold a value for every field
+func checkFieldValues(fields []Field, values []FieldValue) error {
+	if len(values) < len(fields) {
+		return fmt.Errorf("%d values for %d fields", len(values), len(fields))
+	}
+	return nil
+}
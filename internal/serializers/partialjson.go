@@ -0,0 +1,195 @@
+package serializers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// readJSONFields reads fields from a JSON-encoded user by scanning for the object keys of each
+// field and skipping every other value without decoding it, in the style of gjson. As with
+// gjson, the first of duplicate keys wins. It backs ReadFields for JSON, EasyJSON, GoJSON and
+// JSONiter, which all write the same JSON as the standard library.
+func readJSONFields(data []byte, fields []Field, values []FieldValue) error {
+	if err := checkFieldValues(fields, values); err != nil {
+		return err
+	}
+	for i, field := range fields {
+		f := partialFields[field]
+		raw, err := jsonLookup(data, f.keys)
+		if err != nil {
+			return fmt.Errorf("%s: %w", f.path, err)
+		}
+		if values[i], err = jsonFieldValue(raw, f.kind); err != nil {
+			return fmt.Errorf("%s: %w", f.path, err)
+		}
+	}
+	return nil
+}
+
+// jsonLookup returns the raw value at the path of object keys in data, or nil if a key is
+// missing or an object on the path is null
+func jsonLookup(data []byte, keys []string) ([]byte, error) {
+	value := data
+	for _, key := range keys {
+		var err error
+		if value, err = jsonMember(value, key); err != nil || value == nil {
+			return nil, err
+		}
+	}
+	return value, nil
+}
+
+// jsonMember returns the raw value of member key of the object in data, or nil if the object
+// has no such member or data is null
+func jsonMember(data []byte, key string) ([]byte, error) {
+	i := jsonSkipSpace(data, 0)
+	if bytes.HasPrefix(data[i:], []byte("null")) {
+		return nil, nil
+	}
+	if i >= len(data) || data[i] != '{' {
+		return nil, fmt.Errorf("expected JSON object at offset %d", i)
+	}
+
+	i = jsonSkipSpace(data, i+1)
+	if i < len(data) && data[i] == '}' {
+		return nil, nil
+	}
+	for {
+		if i >= len(data) || data[i] != '"' {
+			return nil, fmt.Errorf("expected object key at offset %d", i)
+		}
+		end, err := jsonValueEnd(data, i)
+		if err != nil {
+			return nil, err
+		}
+		name := data[i:end]
+
+		i = jsonSkipSpace(data, end)
+		if i >= len(data) || data[i] != ':' {
+			return nil, fmt.Errorf("expected ':' at offset %d", i)
+		}
+		i = jsonSkipSpace(data, i+1)
+		if end, err = jsonValueEnd(data, i); err != nil {
+			return nil, err
+		}
+		if jsonKeyEquals(name, key) {
+			return data[i:end], nil
+		}
+
+		i = jsonSkipSpace(data, end)
+		switch {
+		case i < len(data) && data[i] == ',':
+			i = jsonSkipSpace(data, i+1)
+		case i < len(data) && data[i] == '}':
+			return nil, nil
+		default:
+			return nil, fmt.Errorf("expected ',' or '}' at offset %d", i)
+		}
+	}
+}
+
+// jsonKeyEquals reports whether the quoted JSON string name equals key
+func jsonKeyEquals(name []byte, key string) bool {
+	inner := name[1 : len(name)-1]
+	if bytes.IndexByte(inner, '\\') < 0 {
+		return string(inner) == key
+	}
+	var unquoted string
+	return json.Unmarshal(name, &unquoted) == nil && unquoted == key
+}
+
+// jsonValueEnd returns the offset just past the JSON value starting at i. Nested objects and
+// arrays are skipped by counting brackets outside strings, without validating their contents.
+func jsonValueEnd(data []byte, i int) (int, error) {
+	if i >= len(data) {
+		return 0, fmt.Errorf("unexpected end of JSON input")
+	}
+	switch data[i] {
+	case '"':
+		for j := i + 1; j < len(data); j++ {
+			switch data[j] {
+			case '\\':
+				j++
+			case '"':
+				return j + 1, nil
+			}
+		}
+		return 0, fmt.Errorf("unterminated string at offset %d", i)
+	case '{', '[':
+		depth := 0
+		for j := i; j < len(data); j++ {
+			switch data[j] {
+			case '"':
+				end, err := jsonValueEnd(data, j)
+				if err != nil {
+					return 0, err
+				}
+				j = end - 1
+			case '{', '[':
+				depth++
+			case '}', ']':
+				if depth--; depth == 0 {
+					return j + 1, nil
+				}
+			}
+		}
+		return 0, fmt.Errorf("unterminated object or array at offset %d", i)
+	default:
+		j := i
+		for j < len(data) && strings.IndexByte(",}] \t\r\n", data[j]) < 0 {
+			j++
+		}
+		if j == i {
+			return 0, fmt.Errorf("expected JSON value at offset %d", i)
+		}
+		return j, nil
+	}
+}
+
+// jsonSkipSpace returns the offset of the first non-whitespace byte at or after i
+func jsonSkipSpace(data []byte, i int) int {
+	for i < len(data) && (data[i] == ' ' || data[i] == '\t' || data[i] == '\r' || data[i] == '\n') {
+		i++
+	}
+	return i
+}
+
+// jsonFieldValue converts the raw JSON value of a field of the given kind. A missing (nil) or
+// null value is the zero value, as encoding/json leaves the field unset.
+func jsonFieldValue(raw []byte, kind fieldKind) (FieldValue, error) {
+	if raw == nil || string(raw) == "null" {
+		return FieldValue{}, nil
+	}
+	switch kind {
+	case kindInt64, kindInt32:
+		n, err := strconv.ParseInt(string(raw), 10, 64)
+		if err != nil {
+			return FieldValue{}, err
+		}
+		return FieldValue{Int: n}, nil
+	case kindBool:
+		switch string(raw) {
+		case "true":
+			return FieldValue{Bool: true}, nil
+		case "false":
+			return FieldValue{}, nil
+		}
+		return FieldValue{}, fmt.Errorf("invalid JSON boolean %q", raw)
+	default:
+		if raw[0] != '"' {
+			return FieldValue{}, fmt.Errorf("invalid JSON string %q", raw)
+		}
+		inner := raw[1 : len(raw)-1]
+		if bytes.IndexByte(inner, '\\') < 0 {
+			return FieldValue{Bytes: inner}, nil
+		}
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return FieldValue{}, err
+		}
+		return FieldValue{Bytes: []byte(s)}, nil
+	}
+}
@@ -0,0 +1,139 @@
+package serializers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestJSONValueEnd(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		i    int
+		want int // offset past the value, -1 if jsonValueEnd fails
+	}{
+		{"string", `"abc",`, 0, 5},
+		{"escaped quote", `"a\"b",`, 0, 6},
+		{"escaped backslash", `"a\\",`, 0, 5},
+		{"escaped backslash and quote", `"\\\"",`, 0, 6},
+		{"number", `-12.5e3}`, 0, 7},
+		{"number at end", `42`, 0, 2},
+		{"null", `null,`, 0, 4},
+		{"true", `true ]`, 0, 4},
+		{"offset", `{"a": 1}`, 6, 7},
+		{"empty object", `{},`, 0, 2},
+		{"nested", `{"a": [1, {"b": []}], "c": {}} ,`, 0, 30},
+		{"brackets in strings", `{"}": "]", "\"{": "["}x`, 0, 22},
+		{"array", `[null, "]", [{}]],`, 0, 17},
+		{"unterminated string", `"abc`, 0, -1},
+		{"unterminated escape", `"abc\"`, 0, -1},
+		{"unterminated object", `{"a": [1]`, 0, -1},
+		{"no value", `,`, 0, -1},
+		{"end of input", `{"a":`, 5, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := jsonValueEnd([]byte(tt.data), tt.i)
+			if tt.want < 0 {
+				if err == nil {
+					t.Errorf("jsonValueEnd(%s, %d) = %d, want an error", tt.data, tt.i, got)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("jsonValueEnd(%s, %d) = (%d, %v), want %d", tt.data, tt.i, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONLookup(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		keys    []string
+		want    string // raw value, empty if the key is missing
+		wantErr bool
+	}{
+		{"first key", `{"id": 1, "name": "x"}`, []string{"id"}, `1`, false},
+		{"last key", `{"id": 1, "name": "x"}`, []string{"name"}, `"x"`, false},
+		{"whitespace", " {\n\t\"id\" :\r\n 7 } ", []string{"id"}, `7`, false},
+		{"escaped quote in value", `{"a": "say \"b\": 1", "b": 2}`, []string{"b"}, `2`, false},
+		{"escaped backslash in value", `{"a": "c:\\", "b": "\\\""}`, []string{"b"}, `"\\\""`, false},
+		{"escaped quote in key", `{"a\"b": 1, "ab": 2}`, []string{`a"b`}, `1`, false},
+		{"escaped backslash in key", `{"a\\": 1}`, []string{`a\`}, `1`, false},
+		{"unicode escape in key", `{"\u0069d": 5}`, []string{"id"}, `5`, false},
+		{"nested", `{"profile": {"prefs": {"theme": "dark"}}}`, []string{"profile", "prefs", "theme"}, `"dark"`, false},
+		{"skips nested values", `{"tags": ["a", {"id": 9}], "meta": {"id": 8}, "id": 3}`, []string{"id"}, `3`, false},
+		{"object value", `{"settings": {"limits": {"x": 1}}}`, []string{"settings"}, `{"limits": {"x": 1}}`, false},
+		{"null value", `{"name": null}`, []string{"name"}, `null`, false},
+		{"null object on path", `{"profile": null}`, []string{"profile", "bio"}, ``, false},
+		{"missing key", `{"id": 1}`, []string{"name"}, ``, false},
+		{"missing nested key", `{"profile": {"bio": "x"}}`, []string{"profile", "avatar"}, ``, false},
+		{"empty object", `{}`, []string{"id"}, ``, false},
+		{"duplicate keys", `{"id": 1, "id": 2}`, []string{"id"}, `1`, false},
+		{"duplicate nested keys", `{"p": {"a": 1}, "p": {"a": 2}}`, []string{"p", "a"}, `1`, false},
+		{"not an object", `[1, 2]`, []string{"id"}, ``, true},
+		{"scalar on path", `{"profile": 3}`, []string{"profile", "bio"}, ``, true},
+		{"missing colon", `{"id" 1}`, []string{"id"}, ``, true},
+		{"missing comma", `{"a": 1 "id": 2}`, []string{"id"}, ``, true},
+		{"unquoted key", `{id: 1}`, []string{"id"}, ``, true},
+		{"truncated", `{"a": 1,`, []string{"id"}, ``, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := jsonLookup([]byte(tt.data), tt.keys)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("jsonLookup(%s, %q) = %s, want an error", tt.data, tt.keys, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("jsonLookup(%s, %q) failed: %v", tt.data, tt.keys, err)
+			}
+			if (tt.want == "") != (got == nil) || string(got) != tt.want {
+				t.Errorf("jsonLookup(%s, %q) = %q, want %q", tt.data, tt.keys, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONFieldValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		kind    fieldKind
+		want    FieldValue
+		wantErr bool
+	}{
+		{"int", `-42`, kindInt64, FieldValue{Int: -42}, false},
+		{"int32", `2147483647`, kindInt32, FieldValue{Int: 2147483647}, false},
+		{"true", `true`, kindBool, FieldValue{Bool: true}, false},
+		{"false", `false`, kindBool, FieldValue{}, false},
+		{"string", `"dark"`, kindString, FieldValue{Bytes: []byte("dark")}, false},
+		{"escaped string", `"a\"b\\c\u00e9"`, kindString, FieldValue{Bytes: []byte("a\"b\\cé")}, false},
+		{"null int", `null`, kindInt64, FieldValue{}, false},
+		{"null string", `null`, kindString, FieldValue{}, false},
+		{"float as int", `1.5`, kindInt64, FieldValue{}, true},
+		{"string as bool", `"true"`, kindBool, FieldValue{}, true},
+		{"number as string", `12`, kindString, FieldValue{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := jsonFieldValue([]byte(tt.raw), tt.kind)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("jsonFieldValue(%s) = %+v, want an error", tt.raw, got)
+				}
+				return
+			}
+			if err != nil || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("jsonFieldValue(%s) = (%+v, %v), want %+v", tt.raw, got, err, tt.want)
+			}
+		})
+	}
+	if got, err := jsonFieldValue(nil, kindString); err != nil || !reflect.DeepEqual(got, FieldValue{}) {
+		t.Errorf("jsonFieldValue of a missing value = (%+v, %v), want the zero value", got, err)
+	}
+}
@@ -1,10 +1,14 @@
 package serializers
 
 import (
+	"bufio"
 	"encoding/json"
 	"fmt"
+	"io"
 	"time"
 
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -34,6 +38,15 @@ func (p *ProtobufSerializer) Marshal(user models.User) ([]byte, error) {
 	return proto.Marshal(pbUser)
 }
 
+// AppendMarshal appends the Protocol Buffer encoding of a User to dst
+func (p *ProtobufSerializer) AppendMarshal(dst []byte, user models.User) ([]byte, error) {
+	pbUser, err := p.convertUserToProto(user)
+	if err != nil {
+		return dst, err
+	}
+	return proto.MarshalOptions{}.MarshalAppend(dst, pbUser)
+}
+
 // Unmarshal deserializes Protocol Buffer bytes to a User
 func (p *ProtobufSerializer) Unmarshal(data []byte) (models.User, error) {
 	var pbUser pb.User
@@ -43,6 +56,89 @@ func (p *ProtobufSerializer) Unmarshal(data []byte) (models.User, error) {
 	return p.convertUserFromProto(&pbUser)
 }
 
+// ReadFields reads fields from Protocol Buffer bytes by scanning the wire format with
+// protowire and skipping other fields without decoding them. Strings are returned without
+// copying and, unlike with proto.Unmarshal, are not checked to be valid UTF-8.
+func (p *ProtobufSerializer) ReadFields(data []byte, fields []Field, values []FieldValue) error {
+	if err := checkFieldValues(fields, values); err != nil {
+		return err
+	}
+	for i, field := range fields {
+		f := partialFields[field]
+		value, _, err := protoField(data, f.proto, f.kind)
+		if err != nil {
+			return fmt.Errorf("%s: %w", f.path, err)
+		}
+		values[i] = value
+	}
+	return nil
+}
+
+// protoField scans message for the field at the path of field numbers. As with proto.Unmarshal,
+// the last occurrence of a scalar wins, repeated occurrences of a message are merged and a
+// field with an unexpected wire type is skipped as unknown, so the whole message is scanned;
+// found reports whether the field occurred at all.
+func protoField(message []byte, path []protowire.Number, kind fieldKind) (value FieldValue, found bool, err error) {
+	want := protowire.VarintType
+	if len(path) > 1 || kind == kindString {
+		want = protowire.BytesType
+	}
+
+	for len(message) > 0 {
+		num, typ, n := protowire.ConsumeTag(message)
+		if n < 0 {
+			return FieldValue{}, false, protowire.ParseError(n)
+		}
+		message = message[n:]
+
+		if num != path[0] || typ != want {
+			if n = protowire.ConsumeFieldValue(num, typ, message); n < 0 {
+				return FieldValue{}, false, protowire.ParseError(n)
+			}
+			message = message[n:]
+			continue
+		}
+
+		if want == protowire.BytesType {
+			b, n := protowire.ConsumeBytes(message)
+			if n < 0 {
+				return FieldValue{}, false, protowire.ParseError(n)
+			}
+			message = message[n:]
+
+			if len(path) == 1 {
+				value, found = FieldValue{Bytes: b}, true
+				continue
+			}
+			nested, ok, err := protoField(b, path[1:], kind)
+			if err != nil {
+				return FieldValue{}, false, err
+			}
+			if ok {
+				value, found = nested, true
+			}
+			continue
+		}
+
+		v, n := protowire.ConsumeVarint(message)
+		if n < 0 {
+			return FieldValue{}, false, protowire.ParseError(n)
+		}
+		message = message[n:]
+
+		switch kind {
+		case kindBool:
+			value = FieldValue{Bool: v != 0}
+		case kindInt32:
+			value = FieldValue{Int: int64(int32(v))}
+		default:
+			value = FieldValue{Int: int64(v)}
+		}
+		found = true
+	}
+	return value, found, nil
+}
+
 // MarshalUsers serializes a collection of Users to Protocol Buffer bytes
 func (p *ProtobufSerializer) MarshalUsers(users models.Users) ([]byte, error) {
 	pbUserList := &pb.UserList{
@@ -91,11 +187,16 @@ func (p *ProtobufSerializer) convertUserToProto(user models.User) (*pb.User, err
 		metadata[k] = string(jsonBytes)
 	}
 
+	age, err := toInt32(p.Name(), "Age", user.Age)
+	if err != nil {
+		return nil, err
+	}
+
 	pbUser := &pb.User{
 		Id:        user.ID,
 		Name:      user.Name,
 		Email:     user.Email,
-		Age:       int32(user.Age),
+		Age:       age,
 		IsActive:  user.IsActive,
 		Tags:      user.Tags,
 		Metadata:  metadata,
@@ -281,7 +382,11 @@ func (p *ProtobufSerializer) convertSettingsToProto(settings models.Settings) (*
 	// Convert int to int32 for limits
 	limits := make(map[string]int32)
 	for k, v := range settings.Limits {
-		limits[k] = int32(v)
+		limit, err := toInt32(p.Name(), "Settings.Limits."+k, v)
+		if err != nil {
+			return nil, err
+		}
+		limits[k] = limit
 	}
 
 	return &pb.Settings{
@@ -332,3 +437,54 @@ func (p *ProtobufSerializer) isEmptyPreferences(preferences models.Preferences)
 func (p *ProtobufSerializer) isEmptyPrivacySettings(privacy models.PrivacySettings) bool {
 	return !privacy.ProfilePublic && !privacy.EmailVisible && !privacy.ShowActivity
 }
+
+// NewStreamEncoder creates an encoder writing length-delimited Protocol Buffer users
+func (p *ProtobufSerializer) NewStreamEncoder(w io.Writer) StreamEncoder {
+	return protobufStreamEncoder{p, w}
+}
+
+// NewStreamDecoder creates a decoder reading length-delimited Protocol Buffer users
+func (p *ProtobufSerializer) NewStreamDecoder(r io.Reader) StreamDecoder {
+	br, ok := r.(protodelim.Reader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	return protobufStreamDecoder{p, br}
+}
+
+// protobufStreamEncoder implements StreamEncoder with varint length-prefixed messages
+type protobufStreamEncoder struct {
+	p *ProtobufSerializer
+	w io.Writer
+}
+
+// Encode encodes a single user
+func (e protobufStreamEncoder) Encode(user models.User) error {
+	pbUser, err := e.p.convertUserToProto(user)
+	if err != nil {
+		return err
+	}
+	_, err = protodelim.MarshalTo(e.w, pbUser)
+	return err
+}
+
+// Flush is a no-op because every message is written directly
+func (e protobufStreamEncoder) Flush() error {
+	return nil
+}
+
+// protobufStreamDecoder implements StreamDecoder with varint length-prefixed messages
+type protobufStreamDecoder struct {
+	p *ProtobufSerializer
+	r protodelim.Reader
+}
+
+// Decode decodes a single user
+func (d protobufStreamDecoder) Decode() (models.User, error) {
+	var pbUser pb.User
+	// Disable the default 4 MiB message limit for very large users
+	if err := (protodelim.UnmarshalOptions{MaxSize: -1}).UnmarshalFrom(d.r, &pbUser); err != nil {
+		return models.User{}, err
+	}
+	return d.p.convertUserFromProto(&pbUser)
+}
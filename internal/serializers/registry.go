@@ -0,0 +1,190 @@
+package serializers
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+)
+
+// Registration describes a serializer available for benchmarking
+type Registration struct {
+	Name        string
+	Description string
+	New         func() Serializer
+}
+
+// registry contains all registered serializers in benchmark order
+// (JSON first as the most common format, then alphabetical order)
+var registry = []Registration{
+	{"JSON", "standard library", func() Serializer { return NewJSONSerializer() }},
+	{"CBOR", "github.com/fxamacker/cbor/v2", func() Serializer { return NewCBORSerializer() }},
+	{"EasyJSON", "github.com/mailru/easyjson - high-performance JSON with code generation", func() Serializer { return NewEasyJSONSerializer() }},
+	{"FlatBuffers", "github.com/google/flatbuffers - zero-copy serialization", func() Serializer { return NewFlatBuffersSerializer() }},
+	{"Gob", "standard library", func() Serializer { return NewGobSerializer() }},
+	{"GoJSON", "github.com/goccy/go-json - high-performance JSON", func() Serializer { return NewGoJSONSerializer() }},
+	{"JSONiter", "github.com/json-iterator/go - high-performance JSON", func() Serializer { return NewJSONiterSerializer() }},
+	{"Msgp", "github.com/tinylib/msgp - high-performance MessagePack with code generation", func() Serializer { return NewMsgpSerializer() }},
+	{"MsgPack", "github.com/vmihailenco/msgpack/v5", func() Serializer { return NewMsgPackSerializer() }},
+	{"Protobuf", "google.golang.org/protobuf", func() Serializer { return NewProtobufSerializer() }},
+}
+
+// optional contains variants of registered serializers that are only benchmarked when selected
+// by name or pattern with only, so that a default run has one row per format
+var optional = []Registration{
+	{"FlatBuffersVerified", "github.com/google/flatbuffers - buffers verified before decoding", func() Serializer { return NewVerifiedFlatBuffersSerializer() }},
+}
+
+// Registered returns all registered serializers in benchmark order
+func Registered() []Registration {
+	return append([]Registration(nil), registry...)
+}
+
+// Optional returns the serializers that are only benchmarked when selected explicitly
+func Optional() []Registration {
+	return append([]Registration(nil), optional...)
+}
+
+// Lookup returns the registered or optional serializer with the given name (case-insensitive).
+// Names of the form "<Serializer>+<codec>[:<level>]", e.g. "Msgp+zlib" or "JSON+gzip:9",
+// resolve to the serializer wrapped in a CompressedSerializer.
+func Lookup(name string) (Registration, bool) {
+	for _, r := range slices.Concat(registry, optional) {
+		if strings.EqualFold(r.Name, name) {
+			return r, true
+		}
+	}
+
+	base, spec, found := strings.Cut(name, "+")
+	if !found {
+		return Registration{}, false
+	}
+	inner, ok := Lookup(base)
+	if !ok {
+		return Registration{}, false
+	}
+	codec, err := NewCodec(spec)
+	if err != nil {
+		return Registration{}, false
+	}
+	return compressed(inner, codec), true
+}
+
+// compressed returns the registration of inner wrapped with codec
+func compressed(inner Registration, codec Codec) Registration {
+	return Registration{
+		Name:        inner.Name + "+" + codec.Name(),
+		Description: fmt.Sprintf("%s, %s compressed", inner.Description, codec.Name()),
+		New: func() Serializer {
+			return NewCompressedSerializer(inner.New(), codec)
+		},
+	}
+}
+
+// candidates returns the registered and optional serializers, each followed by its compressed
+// variants at the default level of every registered codec
+func candidates() []Registration {
+	var all []Registration
+	for _, r := range slices.Concat(registry, optional) {
+		all = append(all, r)
+		for _, c := range codecs {
+			codec, err := c.factory(DefaultCompressionLevel)
+			if err != nil {
+				continue
+			}
+			all = append(all, compressed(r, codec))
+		}
+	}
+	return all
+}
+
+// Select returns the registered serializers matching any of the only patterns (all
+// uncompressed registered serializers if only is empty) and none of the exclude patterns.
+// Patterns are names or path.Match glob patterns such as "*JSON*" and are matched
+// case-insensitively. Optional serializers are only selected by only patterns, and compressed
+// variants only by only patterns containing "+", such as "Msgp+*"; a non-default level must
+// be spelled out exactly, e.g. "JSON+gzip:9".
+func Select(only, exclude []string) ([]Registration, error) {
+	pool := registry
+	if len(only) > 0 {
+		pool = candidates()
+		for _, pattern := range only {
+			r, ok := Lookup(pattern)
+			if ok && !slices.ContainsFunc(pool, func(c Registration) bool { return strings.EqualFold(c.Name, r.Name) }) {
+				pool = append(pool, r)
+			}
+		}
+	}
+
+	for _, pattern := range only {
+		if err := checkPattern(pool, pattern, selects); err != nil {
+			return nil, err
+		}
+	}
+	for _, pattern := range exclude {
+		if err := checkPattern(pool, pattern, matches); err != nil {
+			return nil, err
+		}
+	}
+
+	var selected []Registration
+	for _, r := range pool {
+		if len(only) > 0 && !slices.ContainsFunc(only, func(p string) bool { return selects(p, r.Name) }) {
+			continue
+		}
+		if slices.ContainsFunc(exclude, func(p string) bool { return matches(p, r.Name) }) {
+			continue
+		}
+		selected = append(selected, r)
+	}
+
+	if len(selected) == 0 {
+		return nil, fmt.Errorf("no serializers selected")
+	}
+	return selected, nil
+}
+
+// NewSerializers creates a serializer for each registration
+func NewSerializers(registrations []Registration) []Serializer {
+	serializers := make([]Serializer, len(registrations))
+	for i, r := range registrations {
+		serializers[i] = r.New()
+	}
+	return serializers
+}
+
+// ParsePatterns splits a comma-separated list of serializer names or patterns
+func ParsePatterns(s string) []string {
+	var patterns []string
+	for _, pattern := range strings.Split(s, ",") {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			patterns = append(patterns, pattern)
+		}
+	}
+	return patterns
+}
+
+// checkPattern validates the syntax of pattern and checks that it matches a registration
+func checkPattern(registrations []Registration, pattern string, match func(pattern, name string) bool) error {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+	}
+	for _, r := range registrations {
+		if match(pattern, r.Name) {
+			return nil
+		}
+	}
+	return fmt.Errorf("pattern %q matches no registered serializer", pattern)
+}
+
+// matches reports whether name matches pattern (case-insensitive)
+func matches(pattern, name string) bool {
+	matched, _ := path.Match(strings.ToLower(pattern), strings.ToLower(name))
+	return matched
+}
+
+// selects reports whether an only pattern selects name. Compressed variants are only
+// selected by patterns that name a codec, so "*JSON*" does not select "JSON+gzip".
+func selects(pattern, name string) bool {
+	return strings.Contains(pattern, "+") == strings.Contains(name, "+") && matches(pattern, name)
+}
@@ -0,0 +1,111 @@
+package serializers
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParsePatterns(t *testing.T) {
+	tests := []struct {
+		s    string
+		want []string
+	}{
+		{"", nil},
+		{" , ,", nil},
+		{"JSON", []string{"JSON"}},
+		{" JSON , Msgp+gzip:9,,*Pack ", []string{"JSON", "Msgp+gzip:9", "*Pack"}},
+	}
+	for _, tt := range tests {
+		if got := ParsePatterns(tt.s); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParsePatterns(%q) = %q, want %q", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestSelect(t *testing.T) {
+	all := []string{"JSON", "CBOR", "EasyJSON", "FlatBuffers", "Gob", "GoJSON", "JSONiter", "Msgp", "MsgPack", "Protobuf"}
+
+	tests := []struct {
+		name          string
+		only, exclude string
+		want          []string
+		err           string // substring of the error, empty if Select succeeds
+	}{
+		{name: "default", want: all},
+		{name: "exact name", only: "Msgp", want: []string{"Msgp"}},
+		{name: "case-insensitive", only: "msgpack,PROTOBUF", want: []string{"MsgPack", "Protobuf"}},
+		{name: "glob", only: "*JSON*", want: []string{"JSON", "EasyJSON", "GoJSON", "JSONiter"}},
+		{name: "case-insensitive glob", only: "msg*", want: []string{"Msgp", "MsgPack"}},
+		{name: "registry order", only: "Protobuf,JSON", want: []string{"JSON", "Protobuf"}},
+		{name: "exclude", exclude: "*JSON*,Gob", want: []string{"CBOR", "FlatBuffers", "Msgp", "MsgPack", "Protobuf"}},
+		{name: "exclude wins over only", only: "*JSON*", exclude: "json", want: []string{"EasyJSON", "GoJSON", "JSONiter"}},
+
+		// Optional serializers are only selected by only patterns
+		{name: "optional by name", only: "FlatBuffersVerified", want: []string{"FlatBuffersVerified"}},
+		{name: "optional by glob", only: "FlatBuffers*", want: []string{"FlatBuffers", "FlatBuffersVerified"}},
+		{name: "optional excluded", only: "FlatBuffers*", exclude: "*Verified", want: []string{"FlatBuffers"}},
+		{name: "optional not excludable by default", exclude: "FlatBuffersVerified", err: "matches no registered serializer"},
+
+		// Compressed variants are only selected by only patterns containing "+"
+		{name: "glob skips compressed", only: "Msgp*", want: []string{"Msgp", "MsgPack"}},
+		{name: "compressed default level", only: "Msgp+gzip", want: []string{"Msgp+gzip"}},
+		{name: "compressed glob", only: "Msgp+*", want: []string{"Msgp+gzip", "Msgp+zlib", "Msgp+flate"}},
+		{name: "compressed level", only: "JSON,JSON+gzip:9", want: []string{"JSON", "JSON+gzip:9"}},
+		{name: "compressed optional", only: "FlatBuffersVerified+zlib", want: []string{"FlatBuffersVerified+zlib"}},
+		{name: "exclude compressed", only: "Msgp+*", exclude: "*+flate", want: []string{"Msgp+gzip", "Msgp+zlib"}},
+
+		{name: "unknown name", only: "YAML", err: `pattern "YAML" matches no registered serializer`},
+		{name: "unknown codec", only: "Msgp+zstd", err: `pattern "Msgp+zstd" matches no registered serializer`},
+		{name: "unknown exclude", exclude: "YAML", err: `pattern "YAML" matches no registered serializer`},
+		{name: "invalid pattern", only: "[JSON", err: `invalid pattern "[JSON"`},
+		{name: "nothing left", only: "Gob", exclude: "*", err: "no serializers selected"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selected, err := Select(ParsePatterns(tt.only), ParsePatterns(tt.exclude))
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("Select error = %v, want one containing %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Select failed: %v", err)
+			}
+
+			var names []string
+			for _, r := range selected {
+				names = append(names, r.Name)
+				if got := r.New().Name(); got != r.Name {
+					t.Errorf("serializer of registration %q is named %q", r.Name, got)
+				}
+			}
+			if !reflect.DeepEqual(names, tt.want) {
+				t.Errorf("Select(%q, %q) = %q, want %q", tt.only, tt.exclude, names, tt.want)
+			}
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name string
+		want string // registration name, empty if not found
+	}{
+		{"json", "JSON"},
+		{"FLATBUFFERSVERIFIED", "FlatBuffersVerified"},
+		{"msgp+GZIP", "Msgp+gzip"},
+		{"JSON+flate:9", "JSON+flate:9"},
+		{"JSON+gzip:42", ""},
+		{"JSON+", ""},
+		{"YAML+gzip", ""},
+		{"YAML", ""},
+	}
+	for _, tt := range tests {
+		r, ok := Lookup(tt.name)
+		if got := r.Name; ok != (tt.want != "") || got != tt.want {
+			t.Errorf("Lookup(%q) = (%q, %t), want %q", tt.name, got, ok, tt.want)
+		}
+	}
+}
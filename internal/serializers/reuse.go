@@ -0,0 +1,30 @@
+package serializers
+
+import "github.com/tomotakashimizu/go-serialization-benchmarks/internal/models"
+
+// clearMaps empties the maps of user, keeping their storage. Reflection-based decoders merge
+// into existing maps, so they are cleared before decoding into a reused user.
+func clearMaps(user *models.User) {
+	clear(user.Metadata)
+	clear(user.Profile.Preferences.Notifications)
+	clear(user.Settings.Limits)
+}
+
+// resetUser zeroes user while keeping the storage of its slices and maps, for decoders that skip
+// null values instead of clearing the field. Slices are truncated and maps cleared, so a null
+// field decodes to an empty, non-nil value instead of the previous user's.
+func resetUser(user *models.User) {
+	clearMaps(user)
+	*user = models.User{
+		Tags:     user.Tags[:0],
+		Metadata: user.Metadata,
+		Profile: models.Profile{
+			SocialLinks: user.Profile.SocialLinks[:0],
+			Preferences: models.Preferences{Notifications: user.Profile.Preferences.Notifications},
+		},
+		Settings: models.Settings{
+			Features: user.Settings.Features[:0],
+			Limits:   user.Settings.Limits,
+		},
+	}
+}
@@ -0,0 +1,123 @@
+package serializers
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/models"
+)
+
+// TestUnmarshalIntoReusedUser decodes a fully populated user and then a sparse one into the
+// same User with every IntoUnmarshaler, checking that nothing of the first user survives and
+// that the result matches Unmarshal up to the empty-versus-nil difference IntoUnmarshaler allows
+func TestUnmarshalIntoReusedUser(t *testing.T) {
+	full := models.GenerateTestUsers(1, 1, models.ProfileHeavy)[0]
+	sparse := models.GenerateTestUsers(2, 2, models.ProfileTypical)[1]
+	sparse.Tags = nil
+	sparse.Metadata = nil
+	sparse.Profile.SocialLinks = nil
+	sparse.Profile.Preferences.Notifications = nil
+	sparse.Settings.Features = nil
+	sparse.Settings.Limits = nil
+
+	for _, r := range candidates() {
+		ser := r.New()
+		ui, ok := ser.(IntoUnmarshaler)
+		if !ok {
+			continue
+		}
+		t.Run(r.Name, func(t *testing.T) {
+			var got models.User
+			for _, user := range []models.User{full, sparse} {
+				data, err := ser.Marshal(user)
+				if err != nil {
+					t.Fatalf("Marshal failed: %v", err)
+				}
+				if err := ui.UnmarshalInto(data, &got); err != nil {
+					t.Fatalf("UnmarshalInto failed for user %d: %v", user.ID, err)
+				}
+				want, err := ser.Unmarshal(data)
+				if err != nil {
+					t.Fatalf("Unmarshal failed for user %d: %v", user.ID, err)
+				}
+				if !reflect.DeepEqual(emptyToNil(got), emptyToNil(want)) {
+					t.Errorf("UnmarshalInto of user %d into a reused user = %+v, Unmarshal = %+v", user.ID, got, want)
+				}
+			}
+		})
+	}
+}
+
+// TestAppendMarshal appends users to buffers that are too small, large enough and already hold
+// a prefix with every AppendMarshaler, checking that the prefix is kept and the appended bytes
+// decode to the same user as the Marshal output
+func TestAppendMarshal(t *testing.T) {
+	users := models.GenerateTestUsers(3, 1, models.ProfileHeavy)
+	prefix := []byte("prefix")
+
+	for _, r := range candidates() {
+		ser := r.New()
+		am, ok := ser.(AppendMarshaler)
+		if !ok {
+			continue
+		}
+		t.Run(r.Name, func(t *testing.T) {
+			buffers := map[string][]byte{
+				"nil":          nil,
+				"small prefix": append(make([]byte, 0, len(prefix)+1), prefix...),
+				"large prefix": append(make([]byte, 0, 1<<20), prefix...),
+			}
+			for name, dst := range buffers {
+				for _, user := range users {
+					start := len(dst)
+					var err error
+					if dst, err = am.AppendMarshal(dst, user); err != nil {
+						t.Fatalf("%s: AppendMarshal of user %d failed: %v", name, user.ID, err)
+					}
+					if name != "nil" && !bytes.HasPrefix(dst, prefix) {
+						t.Fatalf("%s: AppendMarshal of user %d overwrote the prefix", name, user.ID)
+					}
+					got, err := ser.Unmarshal(dst[start:])
+					if err != nil {
+						t.Fatalf("%s: Unmarshal of appended user %d failed: %v", name, user.ID, err)
+					}
+					data, err := ser.Marshal(user)
+					if err != nil {
+						t.Fatalf("Marshal of user %d failed: %v", user.ID, err)
+					}
+					want, err := ser.Unmarshal(data)
+					if err != nil {
+						t.Fatalf("Unmarshal of user %d failed: %v", user.ID, err)
+					}
+					if !reflect.DeepEqual(got, want) {
+						t.Errorf("%s: appended user %d decodes differently from the Marshal output", name, user.ID)
+					}
+				}
+			}
+		})
+	}
+}
+
+// emptyToNil returns user with its empty slices and maps set to nil
+func emptyToNil(user models.User) models.User {
+	if len(user.Tags) == 0 {
+		user.Tags = nil
+	}
+	if len(user.Metadata) == 0 {
+		user.Metadata = nil
+	}
+	if len(user.Profile.SocialLinks) == 0 {
+		user.Profile.SocialLinks = nil
+	}
+	if len(user.Profile.Preferences.Notifications) == 0 {
+		user.Profile.Preferences.Notifications = nil
+	}
+	if len(user.Settings.Features) == 0 {
+		user.Settings.Features = nil
+	}
+	if len(user.Settings.Limits) == 0 {
+		user.Settings.Limits = nil
+	}
+	return user
+}
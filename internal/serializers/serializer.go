@@ -1,6 +1,12 @@
 package serializers
 
-import "github.com/tomotakashimizu/go-serialization-benchmarks/internal/models"
+import (
+	"io"
+
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/fidelity"
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/models"
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/utils"
+)
 
 // Serializer defines the interface for serialization operations
 type Serializer interface {
@@ -11,6 +17,44 @@ type Serializer interface {
 	UnmarshalUsers(data []byte) (models.Users, error)
 }
 
+// StreamSerializer is implemented by serializers that can encode users one by one to an
+// io.Writer and decode them one by one from an io.Reader, without buffering the whole collection
+type StreamSerializer interface {
+	Serializer
+	NewStreamEncoder(w io.Writer) StreamEncoder
+	NewStreamDecoder(r io.Reader) StreamDecoder
+}
+
+// StreamEncoder encodes users one by one
+type StreamEncoder interface {
+	Encode(user models.User) error
+	Flush() error // writes any data buffered by the encoder to the underlying writer
+}
+
+// StreamDecoder decodes users one by one
+type StreamDecoder interface {
+	Decode() (models.User, error) // returns io.EOF after the last user
+}
+
+// AppendMarshaler is implemented by serializers that can append a serialized user to an
+// existing buffer, so callers can reuse one buffer across calls
+type AppendMarshaler interface {
+	AppendMarshal(dst []byte, user models.User) ([]byte, error)
+}
+
+// Wrapper is implemented by serializers that wrap another serializer and transform its output,
+// such as CompressedSerializer
+type Wrapper interface {
+	Inner() Serializer
+}
+
+// IntoUnmarshaler is implemented by serializers that can deserialize into an existing user,
+// reusing its slices and maps where the library allows it. A reused empty slice or map may stay
+// non-nil where Unmarshal would return nil.
+type IntoUnmarshaler interface {
+	UnmarshalInto(data []byte, user *models.User) error
+}
+
 // SerializationResult contains the results of serialization benchmarks
 type SerializationResult struct {
 	SerializerName    string
@@ -21,14 +65,222 @@ type SerializationResult struct {
 	MarshalMedianNs   int64
 	UnmarshalAvgNs    int64
 	UnmarshalMedianNs int64
+
+	// Spread and outlier statistics of the timed iterations
+	MarshalStats   utils.Summary
+	UnmarshalStats utils.Summary
+
+	// Bootstrap confidence intervals for the median times
+	MarshalMedianCI   utils.Interval
+	UnmarshalMedianCI utils.Interval
+
+	// Allocation and GC activity per iteration
+	MarshalAllocStats   []utils.AllocStats
+	UnmarshalAllocStats []utils.AllocStats
+	MarshalAllocAvg     utils.AllocStats
+	UnmarshalAllocAvg   utils.AllocStats
+}
+
+// PerRecordResult contains the results of per-record serialization benchmarks
+type PerRecordResult struct {
+	SerializerName         string
+	RecordCount            int
+	MarshalTimes           []int64 // nanoseconds per iteration (all records)
+	UnmarshalTimes         []int64 // nanoseconds per iteration (all records)
+	MarshalNsPerOp         int64   // median nanoseconds per record
+	UnmarshalNsPerOp       int64   // median nanoseconds per record
+	MarshalRecordsPerSec   float64
+	UnmarshalRecordsPerSec float64
+
+	// Allocation and GC activity per iteration (all records)
+	MarshalAllocAvg   utils.AllocStats
+	UnmarshalAllocAvg utils.AllocStats
+
+	// Per-record serialized size distribution (bytes)
+	SizeMin int
+	SizeAvg int
+	SizeP50 int
+	SizeP99 int
+	SizeMax int
+}
+
+// CompressionResult contains the compressibility analysis of a serializer's MarshalUsers output
+type CompressionResult struct {
+	SerializerName string
+	RawSize        int     // bytes
+	Entropy        float64 // Shannon entropy in bits per byte
+	RawRatioVsJSON float64 // RawSize relative to the raw JSON size
+	Compressed     []CompressedSize
+}
+
+// CompressedSize contains the size of serialized data under one codec and level
+type CompressedSize struct {
+	Codec       string  // codec name and level, e.g. "gzip:9"
+	Size        int     // bytes
+	Ratio       float64 // Size relative to the raw size
+	RatioVsJSON float64 // Size relative to the JSON output under the same codec and level
+}
+
+// StreamResult contains the results of streaming benchmarks
+type StreamResult struct {
+	SerializerName      string
+	RecordCount         int
+	StreamSize          int64   // bytes
+	GenerationNs        int64   // median time to generate the records, excluded from encode times
+	EncodeTimes         []int64 // nanoseconds per iteration
+	DecodeTimes         []int64 // nanoseconds per iteration
+	EncodeMedianNs      int64
+	DecodeMedianNs      int64
+	EncodeRecordsPerSec float64
+	DecodeRecordsPerSec float64
+	EncodeMBPerSec      float64
+	DecodeMBPerSec      float64
+
+	// Peak heap growth over the live heap at the start of each phase (bytes, max of all iterations)
+	EncodePeakHeap uint64
+	DecodePeakHeap uint64
+}
+
+// ReuseResult compares per-record AppendMarshal/UnmarshalInto against Marshal/Unmarshal.
+// Reuse fields stay zero when the serializer does not implement the corresponding interface.
+type ReuseResult struct {
+	SerializerName   string
+	RecordCount      int
+	AppendMarshal    bool // implements AppendMarshaler
+	UnmarshalInto    bool // implements IntoUnmarshaler
+	MarshalNsPerOp   int64
+	UnmarshalNsPerOp int64
+	AppendNsPerOp    int64 // AppendMarshal into one buffer reused for all records
+	IntoNsPerOp      int64 // UnmarshalInto one user reused for all records
+
+	// Allocation and GC activity per iteration (all records)
+	MarshalAllocAvg   utils.AllocStats
+	UnmarshalAllocAvg utils.AllocStats
+	AppendAllocAvg    utils.AllocStats
+	IntoAllocAvg      utils.AllocStats
+}
+
+// PartialResult compares reading selected fields with ReadFields against a full Unmarshal of
+// every record
+type PartialResult struct {
+	SerializerName   string
+	RecordCount      int
+	Fields           []string // Go field paths read by ReadFields
+	UnmarshalNsPerOp int64
+	ReadNsPerOp      int64
+
+	// Allocation and GC activity per iteration (all records)
+	UnmarshalAllocAvg utils.AllocStats
+	ReadAllocAvg      utils.AllocStats
+}
+
+// ConcurrencyResult contains the aggregate throughput of per-record Marshal/Unmarshal calls
+// made from several goroutines at once for a fixed duration
+type ConcurrencyResult struct {
+	SerializerName     string
+	Workers            int   // number of goroutines
+	DurationNs         int64 // measured wall time of each operation
+	MarshalOps         int64
+	UnmarshalOps       int64
+	MarshalOpsPerSec   float64
+	UnmarshalOpsPerSec float64
+
+	// Per-call latency percentiles (nanoseconds, sampled)
+	MarshalLatency   utils.LatencyPercentiles
+	UnmarshalLatency utils.LatencyPercentiles
+
+	// Ops/s relative to Workers times the single-goroutine ops/s (1 = linear scaling)
+	MarshalEfficiency   float64
+	UnmarshalEfficiency float64
+}
+
+// FidelityResult contains the field-level round-trip fidelity of a serializer over all test users
+type FidelityResult struct {
+	SerializerName string
+	Users          int              // users round-tripped
+	Errors         int              // users that failed to marshal or unmarshal
+	FirstError     string           // message of the first failure
+	Fields         []fidelity.Field // per field path, in struct order
+}
+
+// Outcomes of a Metadata value round trip
+const (
+	MetadataExact     = "exact"     // same type and value
+	MetadataConverted = "converted" // different type, same value (e.g. int -> float64 598)
+	MetadataLossy     = "lossy"     // the value changed (e.g. []byte -> base64 string)
+	MetadataDropped   = "dropped"   // the key is missing after the round trip
+	MetadataError     = "error"     // marshal or unmarshal failed
+)
+
+// MetadataTypeResult contains the Go types a serializer restores for Metadata values of each kind
+type MetadataTypeResult struct {
+	SerializerName string
+	Checks         []MetadataTypeCheck
+}
+
+// MetadataTypeCheck contains the round trip of a single Metadata value
+type MetadataTypeCheck struct {
+	Kind         string // name of the probe, e.g. "int8" or "nested map"
+	OriginalType string
+	RestoredType string // empty if dropped or failed
+	Outcome      string // one of the Metadata* outcomes
+	Detail       string // restored value or error
 }
 
 // SymmetryResult contains the results of strict type preservation tests
 type SymmetryResult struct {
 	SerializerName      string
-	StrictEmptySlicesOK bool // Strict type preservation ([] stays [])
-	StrictEmptyMapsOK   bool // Strict type preservation ({} stays {})
-	StrictNilSlicesOK   bool // Strict nil preservation (nil stays nil)
-	StrictNilMapsOK     bool // Strict nil preservation (nil stays nil)
+	StrictEmptySlicesOK bool   // Strict type preservation ([] stays [])
+	StrictEmptyMapsOK   bool   // Strict type preservation ({} stays {})
+	StrictNilSlicesOK   bool   // Strict nil preservation (nil stays nil)
+	StrictNilMapsOK     bool   // Strict nil preservation (nil stays nil)
+	TimeNanosecondsOK   bool   // Nanosecond precision of time.Time preserved
+	TimeLocationOK      bool   // Location() of a non-UTC time.Time preserved (Asia/Tokyo stays Asia/Tokyo)
+	TimeZeroOK          bool   // Zero time.Time stays zero (IsZero)
+	TimeRangeOK         bool   // Pre-1970 and far-future (year 9999) instants preserved
+	TimeEqualOK         bool   // Every restored time.Time is Equal to the original
+	TimeIdenticalOK     bool   // Restored time.Time values except Asia/Tokyo are == to the originals without monotonic reading
+	NumericInt64        string // Max/min int64 IDs (one of the Numeric* outcomes)
+	NumericInt32        string // Age and Limits outside the int32 range (one of the Numeric* outcomes)
+	NumericFloat53      string // ID and Metadata value 2^53+1, beyond exact float64 integers (one of the Numeric* outcomes)
+	StringMultiByte     string // Japanese text and accented letters (one of the String* outcomes)
+	StringEmojiZWJ      string // Emoji joined by zero-width joiners (one of the String* outcomes)
+	StringInvalidUTF8   string // Invalid UTF-8 bytes (one of the String* outcomes)
+	StringNUL           string // Embedded NUL byte (one of the String* outcomes)
+	StringHTML          string // HTML-sensitive <, > and & (one of the String* outcomes)
+	StringLong          string // Mixed text of over 1 MiB (one of the String* outcomes)
 	Details             string
 }
+
+// CorruptionResult contains the Unmarshal outcomes of damaged encodings for a single serializer
+type CorruptionResult struct {
+	SerializerName string
+	Damages        []CorruptionCounts // one entry per kind of damage
+}
+
+// CorruptionCounts counts the Unmarshal outcomes of one kind of damage
+type CorruptionCounts struct {
+	Damage  string // e.g. "cut at 50%" or "flip 1 bit"
+	Trials  int
+	Errors  int // Unmarshal returned an error
+	Panics  int // Unmarshal panicked, or returned a PanicError for a panic it recovered itself
+	Wrong   int // Unmarshal succeeded with data differing from the undamaged encoding
+	Correct int // Unmarshal succeeded with the data of the undamaged encoding
+}
+
+// Outcomes of a string symmetry check
+const (
+	StringPreserved = "preserved" // same bytes after the round trip, stored verbatim
+	StringEscaped   = "escaped"   // same bytes after the round trip, escaped in the encoding
+	StringReplaced  = "replaced"  // invalid UTF-8 replaced with U+FFFD
+	StringLost      = "lost"      // the string changed otherwise
+	StringError     = "error"     // marshal or unmarshal failed
+)
+
+// Outcomes of a numeric symmetry check
+const (
+	NumericPreserved = "preserved" // every value survived the round trip
+	NumericRejected  = "rejected"  // marshal returned an OverflowError
+	NumericLost      = "lost"      // a value changed without an error
+	NumericError     = "error"     // marshal or unmarshal failed otherwise
+)
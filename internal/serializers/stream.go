@@ -0,0 +1,30 @@
+package serializers
+
+import "github.com/tomotakashimizu/go-serialization-benchmarks/internal/models"
+
+// valueEncoder adapts library stream encoders with an Encode(v any) error method
+type valueEncoder struct {
+	enc interface{ Encode(v any) error }
+}
+
+// Encode encodes a single user
+func (e valueEncoder) Encode(user models.User) error {
+	return e.enc.Encode(user)
+}
+
+// Flush is a no-op because the library encoders write every value directly
+func (e valueEncoder) Flush() error {
+	return nil
+}
+
+// valueDecoder adapts library stream decoders with a Decode(v any) error method
+type valueDecoder struct {
+	dec interface{ Decode(v any) error }
+}
+
+// Decode decodes a single user
+func (d valueDecoder) Decode() (models.User, error) {
+	var user models.User
+	err := d.dec.Decode(&user)
+	return user, err
+}
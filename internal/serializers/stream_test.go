@@ -0,0 +1,94 @@
+package serializers
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/tomotakashimizu/go-serialization-benchmarks/internal/models"
+)
+
+// TestStreamRoundTrip encodes users to a stream and decodes them again with every
+// StreamSerializer, checking that each user decodes like its Marshal output and that Decode
+// returns io.EOF exactly after the last user
+func TestStreamRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		users models.Users
+	}{
+		{"empty", nil},
+		{"single", models.GenerateTestUsers(1, 1, models.ProfileTypical)},
+		{"typical", models.GenerateTestUsers(100, 1, models.ProfileTypical)},
+		{"heavy", models.GenerateTestUsers(10, 1, models.ProfileHeavy)},
+		{"minimal", models.GenerateTestUsers(50, 1, models.ProfileMinimal)},
+	}
+	for _, r := range candidates() {
+		ss, ok := r.New().(StreamSerializer)
+		if !ok {
+			continue
+		}
+		for _, tt := range tests {
+			t.Run(r.Name+"/"+tt.name, func(t *testing.T) {
+				var buf bytes.Buffer
+				enc := ss.NewStreamEncoder(&buf)
+				for _, user := range tt.users {
+					if err := enc.Encode(user); err != nil {
+						t.Fatalf("Encode of user %d failed: %v", user.ID, err)
+					}
+				}
+				if err := enc.Flush(); err != nil {
+					t.Fatalf("Flush failed: %v", err)
+				}
+
+				dec := ss.NewStreamDecoder(&buf)
+				for i, user := range tt.users {
+					got, err := dec.Decode()
+					if err != nil {
+						t.Fatalf("Decode of user %d of %d failed: %v", i+1, len(tt.users), err)
+					}
+					data, err := ss.Marshal(user)
+					if err != nil {
+						t.Fatalf("Marshal of user %d failed: %v", user.ID, err)
+					}
+					want, err := ss.Unmarshal(data)
+					if err != nil {
+						t.Fatalf("Unmarshal of user %d failed: %v", user.ID, err)
+					}
+					if !reflect.DeepEqual(got, want) {
+						t.Fatalf("streamed user %d decodes differently from the Marshal output", user.ID)
+					}
+				}
+				if _, err := dec.Decode(); !errors.Is(err, io.EOF) {
+					t.Errorf("Decode after the last user returned %v, want io.EOF", err)
+				}
+			})
+		}
+
+		t.Run(r.Name+"/truncated", func(t *testing.T) {
+			users := models.GenerateTestUsers(3, 1, models.ProfileTypical)
+			var buf bytes.Buffer
+			enc := ss.NewStreamEncoder(&buf)
+			for _, user := range users {
+				if err := enc.Encode(user); err != nil {
+					t.Fatalf("Encode of user %d failed: %v", user.ID, err)
+				}
+			}
+			if err := enc.Flush(); err != nil {
+				t.Fatalf("Flush failed: %v", err)
+			}
+
+			// A stream cut inside the last user must not end cleanly with io.EOF
+			dec := ss.NewStreamDecoder(bytes.NewReader(buf.Bytes()[:buf.Len()-5]))
+			for i := range users[:len(users)-1] {
+				if _, err := dec.Decode(); err != nil {
+					t.Fatalf("Decode of user %d failed: %v", i+1, err)
+				}
+			}
+			if _, err := dec.Decode(); err == nil || err == io.EOF {
+				t.Errorf("Decode of the truncated last user returned %v, want an error other than io.EOF", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package utils
+
+import "math"
+
+// ShannonEntropy returns the Shannon entropy of the byte distribution of data in bits per
+// byte (0 for constant data, 8 for uniformly random data)
+func ShannonEntropy(data []byte) float64 {
+	if len(data) == 0 {
+		return 0
+	}
+
+	var counts [256]int
+	for _, b := range data {
+		counts[b]++
+	}
+
+	entropy := 0.0
+	total := float64(len(data))
+	for _, count := range counts {
+		if count == 0 {
+			continue
+		}
+		p := float64(count) / total
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
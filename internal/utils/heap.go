@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"runtime/metrics"
+	"time"
+)
+
+// heapObjectsMetric is the runtime metric for the bytes occupied by live and unswept heap objects
+const heapObjectsMetric = "/memory/classes/heap/objects:bytes"
+
+// HeapSampler samples the heap size in the background and records its peak
+type HeapSampler struct {
+	done    chan struct{}
+	stopped chan struct{}
+	peak    uint64
+}
+
+// StartHeapSampler starts sampling the heap size every interval
+func StartHeapSampler(interval time.Duration) *HeapSampler {
+	s := &HeapSampler{
+		done:    make(chan struct{}),
+		stopped: make(chan struct{}),
+		peak:    HeapInUse(),
+	}
+
+	go func() {
+		defer close(s.stopped)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.done:
+				return
+			case <-ticker.C:
+				s.peak = max(s.peak, HeapInUse())
+			}
+		}
+	}()
+
+	return s
+}
+
+// Stop stops sampling and returns the peak heap size in bytes
+func (s *HeapSampler) Stop() uint64 {
+	close(s.done)
+	<-s.stopped
+	return max(s.peak, HeapInUse())
+}
+
+// HeapInUse returns the bytes currently occupied by heap objects, including unswept garbage
+func HeapInUse() uint64 {
+	sample := []metrics.Sample{{Name: heapObjectsMetric}}
+	metrics.Read(sample)
+	return sample[0].Value.Uint64()
+}
@@ -0,0 +1,98 @@
+package utils
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+)
+
+// LatencyPercentiles contains percentiles of per-call latencies in nanoseconds
+type LatencyPercentiles struct {
+	P50 int64
+	P90 int64
+	P99 int64
+	Max int64
+}
+
+// LatencySampler keeps a uniform random sample of a stream of latencies (reservoir sampling)
+// together with the exact count and maximum. It is not safe for concurrent use; use one
+// sampler per goroutine and merge them with MergeLatencies.
+type LatencySampler struct {
+	samples []int64
+	size    int
+	count   int64
+	max     int64
+	rng     *rand.Rand
+}
+
+// NewLatencySampler creates a sampler keeping at most size latencies
+func NewLatencySampler(size int, seed int64) *LatencySampler {
+	return &LatencySampler{
+		samples: make([]int64, 0, size),
+		size:    size,
+		rng:     rand.New(rand.NewSource(seed)),
+	}
+}
+
+// Add records a latency
+func (s *LatencySampler) Add(ns int64) {
+	s.count++
+	s.max = max(s.max, ns)
+	if len(s.samples) < s.size {
+		s.samples = append(s.samples, ns)
+		return
+	}
+	if i := s.rng.Int63n(s.count); i < int64(s.size) {
+		s.samples[i] = ns
+	}
+}
+
+// Count returns the number of recorded latencies
+func (s *LatencySampler) Count() int64 {
+	return s.count
+}
+
+// MergeLatencies calculates latency percentiles over all samplers. Each sample is weighted by
+// the number of latencies it represents, so samplers that recorded more calls count more.
+func MergeLatencies(samplers []*LatencySampler) LatencyPercentiles {
+	type weighted struct {
+		ns     int64
+		weight float64
+	}
+
+	var (
+		all   []weighted
+		total float64
+		p     LatencyPercentiles
+	)
+	for _, s := range samplers {
+		if len(s.samples) == 0 {
+			continue
+		}
+		weight := float64(s.count) / float64(len(s.samples))
+		for _, ns := range s.samples {
+			all = append(all, weighted{ns, weight})
+		}
+		total += float64(s.count)
+		p.Max = max(p.Max, s.max)
+	}
+	if len(all) == 0 {
+		return p
+	}
+	slices.SortFunc(all, func(a, b weighted) int { return cmp.Compare(a.ns, b.ns) })
+
+	percentile := func(q float64) int64 {
+		var cumulative float64
+		for _, w := range all {
+			cumulative += w.weight
+			if cumulative >= q/100*total {
+				return w.ns
+			}
+		}
+		return all[len(all)-1].ns
+	}
+	p.P50 = percentile(50)
+	p.P90 = percentile(90)
+	p.P99 = percentile(99)
+	return p
+}
@@ -0,0 +1,63 @@
+package utils
+
+import "runtime"
+
+// MemSnapshot captures the cumulative allocation and GC counters at a point in time
+type MemSnapshot struct {
+	TotalAlloc   uint64
+	Mallocs      uint64
+	NumGC        uint32
+	PauseTotalNs uint64
+}
+
+// AllocStats contains allocation and GC activity between two snapshots
+type AllocStats struct {
+	Bytes     int64 // bytes allocated
+	Allocs    int64 // number of heap allocations
+	GCCycles  int64 // completed GC cycles
+	GCPauseNs int64 // total stop-the-world pause time in nanoseconds
+}
+
+// TakeMemSnapshot reads the current allocation and GC counters
+func TakeMemSnapshot() MemSnapshot {
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	return MemSnapshot{
+		TotalAlloc:   m.TotalAlloc,
+		Mallocs:      m.Mallocs,
+		NumGC:        m.NumGC,
+		PauseTotalNs: m.PauseTotalNs,
+	}
+}
+
+// Since returns the allocation and GC activity that happened after start
+func (s MemSnapshot) Since(start MemSnapshot) AllocStats {
+	return AllocStats{
+		Bytes:     int64(s.TotalAlloc - start.TotalAlloc),
+		Allocs:    int64(s.Mallocs - start.Mallocs),
+		GCCycles:  int64(s.NumGC - start.NumGC),
+		GCPauseNs: int64(s.PauseTotalNs - start.PauseTotalNs),
+	}
+}
+
+// AverageAllocStats calculates the per-measurement average of a slice of AllocStats,
+// rounded to the nearest integer
+func AverageAllocStats(stats []AllocStats) AllocStats {
+	if len(stats) == 0 {
+		return AllocStats{}
+	}
+	var sum AllocStats
+	for _, s := range stats {
+		sum.Bytes += s.Bytes
+		sum.Allocs += s.Allocs
+		sum.GCCycles += s.GCCycles
+		sum.GCPauseNs += s.GCPauseNs
+	}
+	n := int64(len(stats))
+	return AllocStats{
+		Bytes:     (sum.Bytes + n/2) / n,
+		Allocs:    (sum.Allocs + n/2) / n,
+		GCCycles:  (sum.GCCycles + n/2) / n,
+		GCPauseNs: (sum.GCPauseNs + n/2) / n,
+	}
+}
@@ -0,0 +1,161 @@
+package utils
+
+import (
+	"cmp"
+	"math"
+	"math/rand"
+	"slices"
+)
+
+// Interval represents a closed interval of int64 values
+type Interval struct {
+	Low  int64
+	High int64
+}
+
+// BootstrapMedianCI estimates a confidence interval for the median of values using
+// the percentile bootstrap with the given number of resamples
+func BootstrapMedianCI(values []int64, confidence float64, resamples int, rng *rand.Rand) Interval {
+	if len(values) == 0 {
+		return Interval{}
+	}
+	if len(values) == 1 || resamples <= 0 {
+		median := CalculateMedian(values)
+		return Interval{Low: median, High: median}
+	}
+
+	medians := make([]int64, resamples)
+	sample := make([]int64, len(values))
+	for i := range medians {
+		for j := range sample {
+			sample[j] = values[rng.Intn(len(values))]
+		}
+		medians[i] = CalculateMedian(sample)
+	}
+
+	alpha := (1 - confidence) / 2
+	return Interval{
+		Low:  CalculatePercentile(medians, alpha*100),
+		High: CalculatePercentile(medians, (1-alpha)*100),
+	}
+}
+
+// exactMannWhitneyLimit is the largest sample size for which exact p-values are computed
+const exactMannWhitneyLimit = 20
+
+// MannWhitneyU performs a two-sided Mann-Whitney U test and returns the U statistic of a
+// and the p-value. Small samples without ties use the exact distribution of U, otherwise
+// the normal approximation with tie and continuity correction is used.
+func MannWhitneyU(a, b []int64) (u, pValue float64) {
+	n1, n2 := len(a), len(b)
+	if n1 == 0 || n2 == 0 {
+		return 0, 1
+	}
+
+	// Rank the pooled samples, averaging the ranks of ties
+	type sample struct {
+		value int64
+		fromA bool
+	}
+	pooled := make([]sample, 0, n1+n2)
+	for _, v := range a {
+		pooled = append(pooled, sample{value: v, fromA: true})
+	}
+	for _, v := range b {
+		pooled = append(pooled, sample{value: v})
+	}
+	slices.SortFunc(pooled, func(x, y sample) int {
+		return cmp.Compare(x.value, y.value)
+	})
+
+	var rankSumA, tieCorrection float64
+	hasTies := false
+	for i := 0; i < len(pooled); {
+		j := i
+		for j < len(pooled) && pooled[j].value == pooled[i].value {
+			j++
+		}
+		rank := float64(i+j+1) / 2 // average of ranks i+1..j
+		for k := i; k < j; k++ {
+			if pooled[k].fromA {
+				rankSumA += rank
+			}
+		}
+		if t := float64(j - i); t > 1 {
+			hasTies = true
+			tieCorrection += t*t*t - t
+		}
+		i = j
+	}
+
+	u = rankSumA - float64(n1*(n1+1))/2
+
+	if !hasTies && n1 <= exactMannWhitneyLimit && n2 <= exactMannWhitneyLimit {
+		return u, exactMannWhitneyP(n1, n2, int(u))
+	}
+
+	// Normal approximation
+	n := float64(n1 + n2)
+	mean := float64(n1*n2) / 2
+	variance := float64(n1*n2) / 12 * ((n + 1) - tieCorrection/(n*(n-1)))
+	if variance <= 0 {
+		return u, 1
+	}
+	z := (math.Abs(u-mean) - 0.5) / math.Sqrt(variance)
+	if z < 0 {
+		z = 0
+	}
+	return u, math.Min(1, math.Erfc(z/math.Sqrt2))
+}
+
+// exactMannWhitneyP computes the exact two-sided p-value of U for sample sizes n1 and n2
+func exactMannWhitneyP(n1, n2, u int) float64 {
+	// counts[i][j][k] is the number of arrangements of i a-values and j b-values with U = k
+	maxU := n1 * n2
+	counts := make([][][]float64, n1+1)
+	for i := range counts {
+		counts[i] = make([][]float64, n2+1)
+		for j := range counts[i] {
+			counts[i][j] = make([]float64, maxU+1)
+			if i == 0 || j == 0 {
+				counts[i][j][0] = 1
+				continue
+			}
+			for k := 0; k <= i*j; k++ {
+				// Largest value from a: it exceeds all j b-values
+				if k >= j {
+					counts[i][j][k] += counts[i-1][j][k-j]
+				}
+				// Largest value from b: it adds nothing to U
+				counts[i][j][k] += counts[i][j-1][k]
+			}
+		}
+	}
+
+	var total, lower, upper float64
+	for k, c := range counts[n1][n2] {
+		total += c
+		if k <= u {
+			lower += c
+		}
+		if k >= u {
+			upper += c
+		}
+	}
+	return math.Min(1, 2*math.Min(lower, upper)/total)
+}
+
+// CompareSamples reports whether a is significantly smaller (-1) or larger (1) than b
+// according to a two-sided Mann-Whitney U test at significance level alpha, or 0 if the
+// samples are indistinguishable
+func CompareSamples(a, b []int64, alpha float64) int {
+	u, p := MannWhitneyU(a, b)
+	if p >= alpha {
+		return 0
+	}
+	// U below its expected value means values from a tend to rank lower than b
+	if u < float64(len(a)*len(b))/2 {
+		return -1
+	}
+	return 1
+}
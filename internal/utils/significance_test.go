@@ -0,0 +1,124 @@
+package utils
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestBootstrapMedianCI(t *testing.T) {
+	tests := []struct {
+		name      string
+		values    []int64
+		resamples int
+		want      Interval
+	}{
+		{"empty", nil, 1000, Interval{}},
+		{"single value", []int64{42}, 1000, Interval{Low: 42, High: 42}},
+		{"no resamples", []int64{1, 2, 3}, 0, Interval{Low: 2, High: 2}},
+		{"constant", []int64{7, 7, 7, 7}, 1000, Interval{Low: 7, High: 7}},
+		// Resampled medians are 10, 15 or 20 with probabilities 1/4, 1/2 and 1/4, so the
+		// 2.5th and 97.5th percentiles of 1000 resamples are the extremes
+		{"two values", []int64{10, 20}, 1000, Interval{Low: 10, High: 20}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BootstrapMedianCI(tt.values, 0.95, tt.resamples, rand.New(rand.NewSource(1)))
+			if got != tt.want {
+				t.Errorf("BootstrapMedianCI(%v) = %+v, want %+v", tt.values, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBootstrapMedianCIContainsMedian(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	values := make([]int64, 50)
+	for i := range values {
+		values[i] = 1000 + rng.Int63n(100)
+	}
+	median := CalculateMedian(values)
+
+	ci := BootstrapMedianCI(values, 0.95, 2000, rand.New(rand.NewSource(1)))
+	if ci.Low > median || ci.High < median {
+		t.Errorf("95%% CI %+v does not contain the sample median %d", ci, median)
+	}
+	if ci.Low < 1000 || ci.High >= 1100 {
+		t.Errorf("95%% CI %+v exceeds the sample range [1000, 1100)", ci)
+	}
+	if narrow := BootstrapMedianCI(values, 0.5, 2000, rand.New(rand.NewSource(1))); narrow.Low < ci.Low || narrow.High > ci.High {
+		t.Errorf("50%% CI %+v is not within the 95%% CI %+v", narrow, ci)
+	}
+}
+
+func TestMannWhitneyU(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []int64
+		u, p float64
+	}{
+		{"empty", nil, []int64{1, 2}, 0, 1},
+		// Exact: a ranks 1, 2, 3 so U = 0; 1 of the C(6,3) = 20 arrangements has U <= 0
+		{"exact separated", []int64{1, 2, 3}, []int64{4, 5, 6}, 0, 2.0 / 20},
+		{"exact separated reversed", []int64{4, 5, 6}, []int64{1, 2, 3}, 9, 2.0 / 20},
+		// Exact: a ranks 1, 3, 5 so U = 3; the counts of U = 0..9 are 1 1 2 3 3 3 3 2 1 1,
+		// so P(U <= 3) = 7/20
+		{"exact interleaved", []int64{1, 3, 5}, []int64{2, 4, 6}, 3, 2 * 7.0 / 20},
+		// Ties: the three 2s share rank 3, so a ranks 1, 3, 3 and U = 1. The normal
+		// approximation has mean 4.5 and variance 9/12 * (7 - (27-3)/30) = 4.65, so
+		// z = (|1 - 4.5| - 0.5) / sqrt(4.65)
+		{"ties", []int64{1, 2, 2}, []int64{2, 3, 4}, 1, math.Erfc(3 / math.Sqrt(4.65) / math.Sqrt2)},
+		{"all tied", []int64{5, 5}, []int64{5, 5, 5}, 3, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, p := MannWhitneyU(tt.a, tt.b)
+			if u != tt.u || math.Abs(p-tt.p) > 1e-12 {
+				t.Errorf("MannWhitneyU(%v, %v) = (%g, %g), want (%g, %g)", tt.a, tt.b, u, p, tt.u, tt.p)
+			}
+		})
+	}
+}
+
+func TestMannWhitneyUNormalApproximation(t *testing.T) {
+	// Samples above exactMannWhitneyLimit use the normal approximation even without ties
+	a := make([]int64, exactMannWhitneyLimit+1)
+	b := make([]int64, exactMannWhitneyLimit+1)
+	for i := range a {
+		a[i] = int64(2 * i)
+		b[i] = int64(2*i + 1)
+	}
+	n := float64(len(a))
+	// b[i] exceeds a[0..i], so a-values exceed b[i] only for a[i+1..]
+	wantU := n * (n - 1) / 2
+	z := (math.Abs(wantU-n*n/2) - 0.5) / math.Sqrt(n*n*(2*n+1)/12)
+
+	u, p := MannWhitneyU(a, b)
+	if u != wantU || math.Abs(p-math.Erfc(z/math.Sqrt2)) > 1e-12 {
+		t.Errorf("MannWhitneyU = (%g, %g), want (%g, %g)", u, p, wantU, math.Erfc(z/math.Sqrt2))
+	}
+}
+
+func TestCompareSamples(t *testing.T) {
+	fast := []int64{100, 101, 102, 103, 104, 105}
+	slow := []int64{200, 201, 202, 203, 204, 205}
+	mixed := []int64{100, 201, 102, 203, 104, 205}
+
+	tests := []struct {
+		name string
+		a, b []int64
+		want int
+	}{
+		{"smaller", fast, slow, -1},
+		{"larger", slow, fast, 1},
+		{"indistinguishable", fast, mixed, 0},
+		{"identical", fast, fast, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CompareSamples(tt.a, tt.b, 0.05); got != tt.want {
+				t.Errorf("CompareSamples(%v, %v) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package utils
 
-import "slices"
+import (
+	"math"
+	"slices"
+)
 
 // CalculateAverage calculates the average of a slice of int64 values
 func CalculateAverage(values []int64) int64 {
@@ -31,3 +34,110 @@ func CalculateMedian(values []int64) int64 {
 	}
 	return sorted[n/2]
 }
+
+// CalculatePercentile calculates the p-th percentile (0-100) of a slice of int64 values
+// using the nearest-rank method
+func CalculatePercentile(values []int64, p float64) int64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sorted := make([]int64, len(values))
+	copy(sorted, values)
+	slices.Sort(sorted)
+
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	if rank > len(sorted) {
+		rank = len(sorted)
+	}
+	return sorted[rank-1]
+}
+
+// CalculateStdDev calculates the sample standard deviation of a slice of int64 values
+func CalculateStdDev(values []int64) float64 {
+	if len(values) < 2 {
+		return 0
+	}
+
+	mean := calculateMean(values)
+	var sumSquares float64
+	for _, v := range values {
+		d := float64(v) - mean
+		sumSquares += d * d
+	}
+	return math.Sqrt(sumSquares / float64(len(values)-1))
+}
+
+// CalculateCV calculates the coefficient of variation (standard deviation / mean)
+func CalculateCV(values []int64) float64 {
+	mean := calculateMean(values)
+	if mean == 0 {
+		return 0
+	}
+	return CalculateStdDev(values) / mean
+}
+
+// FindOutliers returns the indexes of values outside [Q1 - 1.5*IQR, Q3 + 1.5*IQR]
+func FindOutliers(values []int64) []int {
+	if len(values) < 4 {
+		return nil
+	}
+
+	q1 := float64(CalculatePercentile(values, 25))
+	q3 := float64(CalculatePercentile(values, 75))
+	iqr := q3 - q1
+	lower := q1 - 1.5*iqr
+	upper := q3 + 1.5*iqr
+
+	var outliers []int
+	for i, v := range values {
+		if float64(v) < lower || float64(v) > upper {
+			outliers = append(outliers, i)
+		}
+	}
+	return outliers
+}
+
+// calculateMean calculates the floating point mean of a slice of int64 values
+func calculateMean(values []int64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range values {
+		sum += float64(v)
+	}
+	return sum / float64(len(values))
+}
+
+// Summary contains descriptive statistics of a slice of int64 measurements
+type Summary struct {
+	Min      int64
+	Max      int64
+	StdDev   float64
+	P90      int64
+	P95      int64
+	P99      int64
+	CV       float64 // coefficient of variation
+	Outliers []int   // indexes of IQR-based outliers
+}
+
+// Summarize calculates descriptive statistics of a slice of int64 values
+func Summarize(values []int64) Summary {
+	if len(values) == 0 {
+		return Summary{}
+	}
+	return Summary{
+		Min:      slices.Min(values),
+		Max:      slices.Max(values),
+		StdDev:   CalculateStdDev(values),
+		P90:      CalculatePercentile(values, 90),
+		P95:      CalculatePercentile(values, 95),
+		P99:      CalculatePercentile(values, 99),
+		CV:       CalculateCV(values),
+		Outliers: FindOutliers(values),
+	}
+}
@@ -0,0 +1,103 @@
+package utils
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestCalculateMedian(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int64
+		want   int64
+	}{
+		{"empty", nil, 0},
+		{"odd", []int64{3, 1, 2}, 2},
+		{"even truncates the mean of the middle values", []int64{4, 1, 3, 2}, 2},
+		{"even", []int64{10, 40, 20, 30}, 25},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := slices.Clone(tt.values)
+			if got := CalculateMedian(tt.values); got != tt.want {
+				t.Errorf("CalculateMedian(%v) = %d, want %d", tt.values, got, tt.want)
+			}
+			if !slices.Equal(tt.values, values) {
+				t.Errorf("CalculateMedian reordered its input to %v", tt.values)
+			}
+		})
+	}
+}
+
+func TestCalculatePercentile(t *testing.T) {
+	// Nearest-rank examples: the p-th percentile is the value at rank ceil(p/100 * n)
+	values := []int64{50, 15, 40, 20, 35}
+	tests := []struct {
+		p    float64
+		want int64
+	}{
+		{0, 15},
+		{5, 15},
+		{30, 20},
+		{40, 20},
+		{50, 35},
+		{90, 50},
+		{100, 50},
+	}
+	for _, tt := range tests {
+		if got := CalculatePercentile(values, tt.p); got != tt.want {
+			t.Errorf("CalculatePercentile(%v, %g) = %d, want %d", values, tt.p, got, tt.want)
+		}
+	}
+	if got := CalculatePercentile(nil, 50); got != 0 {
+		t.Errorf("CalculatePercentile(nil, 50) = %d, want 0", got)
+	}
+}
+
+func TestCalculateStdDevAndCV(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int64
+		stdDev float64
+		cv     float64
+	}{
+		{"empty", nil, 0, 0},
+		{"single value", []int64{7}, 0, 0},
+		{"constant", []int64{5, 5, 5}, 0, 0},
+		// mean 5, squared deviations sum to 32, sample variance 32/7
+		{"sample", []int64{2, 4, 4, 4, 5, 5, 7, 9}, 2.138089935299395, 0.427617987059879},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CalculateStdDev(tt.values); math.Abs(got-tt.stdDev) > 1e-12 {
+				t.Errorf("CalculateStdDev(%v) = %g, want %g", tt.values, got, tt.stdDev)
+			}
+			if got := CalculateCV(tt.values); math.Abs(got-tt.cv) > 1e-12 {
+				t.Errorf("CalculateCV(%v) = %g, want %g", tt.values, got, tt.cv)
+			}
+		})
+	}
+}
+
+func TestFindOutliers(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int64
+		want   []int
+	}{
+		{"too few values", []int64{1, 2, 100}, nil},
+		{"none", []int64{1, 2, 3, 4, 5, 6, 7, 8, 9}, nil},
+		// Q1 = 3, Q3 = 7, IQR = 4: values outside [-3, 13] are outliers
+		{"high", []int64{1, 2, 3, 4, 5, 6, 7, 8, 100}, []int{8}},
+		{"both sides", []int64{-50, 2, 3, 4, 5, 6, 7, 8, 100}, []int{0, 8}},
+		{"on the fence", []int64{1, 2, 3, 4, 5, 6, 7, 8, 13}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FindOutliers(tt.values); !slices.Equal(got, tt.want) {
+				t.Errorf("FindOutliers(%v) = %v, want %v", tt.values, got, tt.want)
+			}
+		})
+	}
+}